@@ -0,0 +1,204 @@
+package main
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-vanta/v2/rest_api"
+	"github.com/turbot/steampipe-plugin-vanta/v2/rest_api/model"
+)
+
+// page is a single page of records returned by a list method
+type page struct {
+	records []interface{}
+	cursor  string // Cursor of the next page
+	hasNext bool
+}
+
+// pageFunc fetches the page of records at the given cursor
+type pageFunc func(ctx context.Context, cursor string) (*page, error)
+
+// entity describes how to export one kind of Vanta data.
+// Entities listed per parent, such as the entities of a test, set parents; others are listed once.
+type entity struct {
+	name    string
+	parents func(ctx context.Context) ([]string, error)
+	pages   func(parentID string) pageFunc
+}
+
+// testEntityRecord is a test entity together with the ID of the test it belongs to
+type testEntityRecord struct {
+	TestID string `json:"testId"`
+	*model.TestEntity
+}
+
+// evidenceRecord is an evidence item together with the ID of the audit it belongs to
+type evidenceRecord struct {
+	AuditID string `json:"auditId"`
+	*model.Evidence
+}
+
+// newPage converts a page of results returned by the client
+func newPage[T any](data []*T, pageInfo model.PageInfo) *page {
+	records := make([]interface{}, 0, len(data))
+	for _, item := range data {
+		records = append(records, item)
+	}
+	return &page{records: records, cursor: pageInfo.EndCursor, hasNext: pageInfo.HasNextPage}
+}
+
+// single returns the page function of an entity that is listed once
+func single(fn pageFunc) func(string) pageFunc {
+	return func(string) pageFunc { return fn }
+}
+
+// getEntities returns the entities to export, in export order
+func getEntities(client rest_api.Vanta, pageSize int, auditIDs []string) []*entity {
+	entities := []*entity{
+		{
+			name: "people",
+			pages: single(func(ctx context.Context, cursor string) (*page, error) {
+				result, err := client.ListPeople(ctx, &model.ListPeopleOptions{Limit: pageSize, Cursor: cursor})
+				if err != nil {
+					return nil, err
+				}
+				return newPage(result.Results.Data, result.Results.PageInfo), nil
+			}),
+		},
+		{
+			name: "groups",
+			pages: single(func(ctx context.Context, cursor string) (*page, error) {
+				result, err := client.ListGroups(ctx, &model.ListGroupsOptions{Limit: pageSize, Cursor: cursor})
+				if err != nil {
+					return nil, err
+				}
+				return newPage(result.Results.Data, result.Results.PageInfo), nil
+			}),
+		},
+		{
+			name: "policies",
+			pages: single(func(ctx context.Context, cursor string) (*page, error) {
+				result, err := client.ListPolicies(ctx, &model.ListPoliciesOptions{Limit: pageSize, Cursor: cursor})
+				if err != nil {
+					return nil, err
+				}
+				return newPage(result.Results.Data, result.Results.PageInfo), nil
+			}),
+		},
+		{
+			name: "integrations",
+			pages: single(func(ctx context.Context, cursor string) (*page, error) {
+				result, err := client.ListConnectedIntegrations(ctx, &model.ListIntegrationsOptions{Limit: pageSize, Cursor: cursor})
+				if err != nil {
+					return nil, err
+				}
+				return newPage(result.Results.Data, result.Results.PageInfo), nil
+			}),
+		},
+		{
+			name: "computers",
+			pages: single(func(ctx context.Context, cursor string) (*page, error) {
+				result, err := client.ListComputers(ctx, &model.ListComputersOptions{Limit: pageSize, Cursor: cursor})
+				if err != nil {
+					return nil, err
+				}
+				return newPage(result.Results.Data, result.Results.PageInfo), nil
+			}),
+		},
+		{
+			name: "vendors",
+			pages: single(func(ctx context.Context, cursor string) (*page, error) {
+				result, err := client.ListVendors(ctx, &model.ListVendorsOptions{Limit: pageSize, Cursor: cursor})
+				if err != nil {
+					return nil, err
+				}
+				return newPage(result.Results.Data, result.Results.PageInfo), nil
+			}),
+		},
+		{
+			name: "vulnerabilities",
+			pages: single(func(ctx context.Context, cursor string) (*page, error) {
+				result, err := client.ListVulnerabilities(ctx, &model.ListVulnerabilitiesOptions{Limit: pageSize, Cursor: cursor})
+				if err != nil {
+					return nil, err
+				}
+				return newPage(result.Results.Data, result.Results.PageInfo), nil
+			}),
+		},
+		{
+			name: "tests",
+			pages: single(func(ctx context.Context, cursor string) (*page, error) {
+				result, err := client.ListTests(ctx, &model.ListTestsOptions{PageSize: pageSize, PageCursor: cursor})
+				if err != nil {
+					return nil, err
+				}
+				return newPage(result.Results.Data, result.Results.PageInfo), nil
+			}),
+		},
+		{
+			name: "test_entities",
+			parents: func(ctx context.Context) ([]string, error) {
+				return listTestIDs(ctx, client, pageSize)
+			},
+			pages: func(testID string) pageFunc {
+				return func(ctx context.Context, cursor string) (*page, error) {
+					result, err := client.ListTestEntities(ctx, testID, &model.ListTestEntitiesOptions{Limit: pageSize, Cursor: cursor})
+					if err != nil {
+						return nil, err
+					}
+					p := newPage(result.Results.Data, result.Results.PageInfo)
+					for i, testEntity := range result.Results.Data {
+						p.records[i] = &testEntityRecord{TestID: testID, TestEntity: testEntity}
+					}
+					return p, nil
+				}
+			},
+		},
+	}
+
+	// Audits cannot be listed through the API, so evidence is exported for the given audits only
+	for _, auditID := range auditIDs {
+		entities = append(entities, &entity{
+			name: "evidence_" + auditID,
+			pages: single(func(ctx context.Context, cursor string) (*page, error) {
+				result, err := client.ListEvidence(ctx, auditID, &model.ListEvidenceOptions{AuditID: auditID, Limit: pageSize, Cursor: cursor})
+				if err != nil {
+					return nil, err
+				}
+				p := newPage(result.Results.Data, result.Results.PageInfo)
+				for i, evidence := range result.Results.Data {
+					p.records[i] = &evidenceRecord{AuditID: auditID, Evidence: evidence}
+				}
+				return p, nil
+			}),
+		})
+	}
+
+	return entities
+}
+
+// listTestIDs returns the IDs of all tests, in the order they are listed
+func listTestIDs(ctx context.Context, client rest_api.Vanta, pageSize int) ([]string, error) {
+	options := &model.ListTestsOptions{PageSize: pageSize}
+
+	var testIDs []string
+	for {
+		result, err := client.ListTests(ctx, options)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, test := range result.Results.Data {
+			testIDs = append(testIDs, test.ID)
+		}
+
+		// Check if there are more pages
+		if !result.Results.PageInfo.HasNextPage {
+			break
+		}
+
+		// Set cursor for next page
+		options.PageCursor = result.Results.PageInfo.EndCursor
+	}
+
+	return testIDs, nil
+}
@@ -0,0 +1,172 @@
+package main
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+)
+
+// exportEntity writes all records of an entity to its NDJSON file, saving progress in the manifest after every page
+func exportEntity(ctx context.Context, dir string, m *manifest, e *entity) error {
+	st := m.Entities[e.name]
+	if st != nil && st.Complete {
+		log.Printf("%s: already exported, %d records", e.name, st.Count)
+		return nil
+	}
+	if st == nil {
+		startedAt := time.Now().UTC()
+		st = &entityState{File: e.name + ".ndjson", StartedAt: &startedAt}
+		if m.Gzip {
+			st.File += ".gz"
+		}
+		m.Entities[e.name] = st
+	}
+
+	parents := []string{""}
+	if e.parents != nil {
+		var err error
+		if parents, err = e.parents(ctx); err != nil {
+			return err
+		}
+	}
+
+	// Resume from the parent and cursor of the last page written
+	start, cursor := 0, st.Cursor
+	if st.Parent != "" {
+		if start = slices.Index(parents, st.Parent); start < 0 {
+			// The parent being exported no longer exists, so the entity is exported again from scratch
+			log.Printf("%s: %s no longer exists, starting over", e.name, st.Parent)
+			start, cursor = 0, ""
+			st.Parent, st.Cursor, st.Count, st.Offset = "", "", 0, 0
+		}
+	}
+
+	w, err := openRecordWriter(filepath.Join(dir, st.File), st.Offset, m.Gzip)
+	if err != nil {
+		return err
+	}
+	defer w.Close()
+
+	for i := start; i < len(parents); i++ {
+		pages := e.pages(parents[i])
+
+		for {
+			p, err := pages(ctx, cursor)
+			if err != nil {
+				return err
+			}
+
+			if st.Offset, err = w.writePage(p.records); err != nil {
+				return err
+			}
+			st.Count += len(p.records)
+
+			// Check if there are more pages
+			if !p.hasNext {
+				break
+			}
+
+			// Set cursor for next page
+			cursor = p.cursor
+			st.Parent, st.Cursor = parents[i], cursor
+			if err = m.save(dir); err != nil {
+				return err
+			}
+		}
+
+		// Move on to the next parent. After the last one, the entity is marked complete
+		// in the same save as its final offset, so a resume never exports it again.
+		cursor = ""
+		st.Parent, st.Cursor = "", ""
+		if i+1 == len(parents) {
+			break
+		}
+		st.Parent = parents[i+1]
+		if err = m.save(dir); err != nil {
+			return err
+		}
+	}
+
+	completedAt := time.Now().UTC()
+	st.Complete = true
+	st.CompletedAt = &completedAt
+	if err = m.save(dir); err != nil {
+		return err
+	}
+	log.Printf("%s: exported %d records to %s", e.name, st.Count, st.File)
+
+	return nil
+}
+
+// recordWriter appends NDJSON records to a file, optionally gzip-compressed.
+// Each page is written as a separate gzip member, so the file can be truncated back to any page boundary.
+type recordWriter struct {
+	file *os.File
+	gzip bool
+}
+
+// openRecordWriter opens the file for writing at offset, discarding anything written after it
+func openRecordWriter(path string, offset int64, gzip bool) (*recordWriter, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %v", path, err)
+	}
+
+	// Records written after the last page recorded in the manifest would be duplicated on resume
+	if err = file.Truncate(offset); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("failed to truncate %s: %v", path, err)
+	}
+	if _, err = file.Seek(offset, io.SeekStart); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("failed to seek %s: %v", path, err)
+	}
+
+	return &recordWriter{file: file, gzip: gzip}, nil
+}
+
+// writePage writes one record per line and returns the size of the file afterwards
+func (w *recordWriter) writePage(records []interface{}) (int64, error) {
+	if len(records) == 0 {
+		return w.file.Seek(0, io.SeekCurrent)
+	}
+
+	buf := bufio.NewWriter(w.file)
+	var dst io.Writer = buf
+	var gw *gzip.Writer
+	if w.gzip {
+		gw = gzip.NewWriter(buf)
+		dst = gw
+	}
+
+	encoder := json.NewEncoder(dst)
+	for _, record := range records {
+		if err := encoder.Encode(record); err != nil {
+			return 0, fmt.Errorf("failed to JSON-encode record: %v", err)
+		}
+	}
+
+	if gw != nil {
+		if err := gw.Close(); err != nil {
+			return 0, fmt.Errorf("failed to compress records: %v", err)
+		}
+	}
+	if err := buf.Flush(); err != nil {
+		return 0, fmt.Errorf("failed to write records: %v", err)
+	}
+
+	return w.file.Seek(0, io.SeekCurrent)
+}
+
+// Close closes the underlying file
+func (w *recordWriter) Close() error {
+	return w.file.Close()
+}
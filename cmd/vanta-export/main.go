@@ -0,0 +1,135 @@
+// Command vanta-export writes a snapshot of Vanta data to one NDJSON file per entity,
+// for loading into a data lake without running Steampipe.
+//
+// Usage:
+//
+//	vanta-export -out ./vanta-2026-10-19 [-gzip] [-audit-ids id1,id2]
+//
+// Credentials are read from VANTA_CLIENT_ID and VANTA_CLIENT_SECRET, or from VANTA_ACCESS_TOKEN.
+//
+// Progress is recorded in manifest.json in the output directory after every page.
+// Running the command again with the same output directory resumes an interrupted export,
+// or starts a new one if the previous export completed.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-vanta/v2/rest_api"
+)
+
+// scopeAuditRead is the OAuth scope required to list the evidence of an audit
+const scopeAuditRead = "auditor-api.audit:read"
+
+// config holds the command line options
+type config struct {
+	dir      string
+	gzip     bool
+	auditIDs []string
+	pageSize int
+	restart  bool
+}
+
+func main() {
+	var cfg config
+	var auditIDs string
+	flag.StringVar(&cfg.dir, "out", "vanta-export", "directory to write the export to")
+	flag.BoolVar(&cfg.gzip, "gzip", false, "gzip-compress the NDJSON files")
+	flag.StringVar(&auditIDs, "audit-ids", "", "comma-separated IDs of the audits to export evidence for")
+	flag.IntVar(&cfg.pageSize, "page-size", 100, "number of records to request per page, 1 to 100")
+	flag.BoolVar(&cfg.restart, "restart", false, "discard the progress of a previous export in the output directory")
+	flag.Parse()
+
+	for _, auditID := range strings.Split(auditIDs, ",") {
+		if auditID = strings.TrimSpace(auditID); auditID != "" {
+			cfg.auditIDs = append(cfg.auditIDs, auditID)
+		}
+	}
+	if cfg.pageSize < 1 || cfg.pageSize > 100 {
+		log.Fatalf("invalid page size %d: must be between 1 and 100", cfg.pageSize)
+	}
+
+	// Progress is saved after every page, so an interrupted export can be resumed
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if err := run(ctx, cfg); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// run exports all entities to the output directory, resuming a previous export if there is one
+func run(ctx context.Context, cfg config) error {
+	client, err := newClient(ctx, cfg.auditIDs)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(cfg.dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create output directory: %v", err)
+	}
+
+	m, err := loadManifest(cfg.dir)
+	if err != nil {
+		return err
+	}
+	if m != nil && cfg.restart {
+		m = nil
+	}
+	if m != nil && m.CompletedAt != nil {
+		// Only unfinished exports are resumed; a completed one is replaced by a new snapshot
+		log.Printf("export in %s completed at %s, starting a new export", cfg.dir, m.CompletedAt.Format(time.RFC3339))
+		m = nil
+	}
+	if m != nil && m.Gzip != cfg.gzip {
+		return fmt.Errorf("the export in %s was started with gzip=%t; use the same setting or -restart", cfg.dir, m.Gzip)
+	}
+	if m == nil {
+		m = &manifest{
+			StartedAt: time.Now().UTC(),
+			Gzip:      cfg.gzip,
+			Entities:  map[string]*entityState{},
+		}
+	} else {
+		log.Printf("resuming export started at %s", m.StartedAt.Format(time.RFC3339))
+	}
+
+	for _, e := range getEntities(client, cfg.pageSize, cfg.auditIDs) {
+		if err = exportEntity(ctx, cfg.dir, m, e); err != nil {
+			return fmt.Errorf("failed to export %s: %w", e.name, err)
+		}
+	}
+
+	completedAt := time.Now().UTC()
+	m.CompletedAt = &completedAt
+	return m.save(cfg.dir)
+}
+
+// newClient creates a read-only Vanta client from the credentials in the environment
+func newClient(ctx context.Context, auditIDs []string) (rest_api.Vanta, error) {
+	scopes := []string{rest_api.ScopeAllRead}
+	if len(auditIDs) > 0 {
+		scopes = append(scopes, scopeAuditRead)
+	}
+	options := []rest_api.Option{rest_api.WithScopes(scopes...)}
+
+	clientID, clientSecret := os.Getenv("VANTA_CLIENT_ID"), os.Getenv("VANTA_CLIENT_SECRET")
+	if clientID != "" && clientSecret != "" {
+		options = append(options, rest_api.WithOAuthCredentials(clientID, clientSecret))
+	} else if accessToken := os.Getenv("VANTA_ACCESS_TOKEN"); accessToken != "" {
+		options = append(options, rest_api.WithToken(accessToken))
+	} else {
+		return nil, errors.New("authentication required: set VANTA_CLIENT_ID and VANTA_CLIENT_SECRET, or VANTA_ACCESS_TOKEN")
+	}
+
+	return rest_api.New(ctx, options...)
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const manifestFileName = "manifest.json"
+
+// manifest records the progress of an export. It is rewritten after every page,
+// so an interrupted export can resume from the last page written.
+type manifest struct {
+	StartedAt   time.Time               `json:"startedAt"`
+	CompletedAt *time.Time              `json:"completedAt,omitempty"`
+	Gzip        bool                    `json:"gzip"`
+	Entities    map[string]*entityState `json:"entities"`
+}
+
+// entityState records the progress of the export of one entity
+type entityState struct {
+	File        string     `json:"file"`
+	Count       int        `json:"count"`
+	Complete    bool       `json:"complete"`
+	StartedAt   *time.Time `json:"startedAt,omitempty"`
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+
+	// Resume position: the parent being exported, the cursor of the next page
+	// and the size of the file once the last page was written
+	Parent string `json:"parent,omitempty"`
+	Cursor string `json:"cursor,omitempty"`
+	Offset int64  `json:"offset"`
+}
+
+// loadManifest reads the manifest from dir, or returns nil if there is none
+func loadManifest(dir string) (*manifest, error) {
+	data, err := os.ReadFile(filepath.Join(dir, manifestFileName))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %v", err)
+	}
+
+	var m *manifest
+	if err = json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("failed to JSON-decode manifest: %v", err)
+	}
+	if m.Entities == nil {
+		m.Entities = map[string]*entityState{}
+	}
+
+	return m, nil
+}
+
+// save atomically writes the manifest to dir
+func (m *manifest) save(dir string) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to JSON-encode manifest: %v", err)
+	}
+
+	tmp := filepath.Join(dir, manifestFileName+".tmp")
+	if err = os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write manifest: %v", err)
+	}
+	if err = os.Rename(tmp, filepath.Join(dir, manifestFileName)); err != nil {
+		return fmt.Errorf("failed to write manifest: %v", err)
+	}
+
+	return nil
+}
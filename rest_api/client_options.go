@@ -8,7 +8,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"slices"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/turbot/steampipe-plugin-vanta/v2/rest_api/model"
 )
@@ -16,36 +19,75 @@ import (
 const (
 	vantaAPIBaseURL = "https://api.vanta.com"
 	ScopeAllRead    = "vanta-api.all:read"
+	ScopeAllWrite   = "vanta-api.all:write"
 )
 
 // Vanta interface defines the methods available on the client
 type Vanta interface {
 	ListPeople(ctx context.Context, options *model.ListPeopleOptions) (*model.ListPeopleOutput, error)
 	GetPersonByID(ctx context.Context, id string) (*model.Person, error)
+	UpdatePersonEmployment(ctx context.Context, id string, employment *model.Employment) (*model.Person, error)
+	MarkPersonOffboardingComplete(ctx context.Context, id string) (*model.Person, error)
+	AssignPersonCustomTask(ctx context.Context, id string, input *model.CreateCustomTaskInput) (*model.CustomTask, error)
+	CompletePersonCustomTask(ctx context.Context, id, taskID string) (*model.Person, error)
+	AddPersonToGroup(ctx context.Context, id, groupID string) error
 	ListPolicies(ctx context.Context, options *model.ListPoliciesOptions) (*model.ListPoliciesOutput, error)
 	GetPolicyByID(ctx context.Context, id string) (*model.PolicyItem, error)
+	ListPolicyVersions(ctx context.Context, policyID string, options *model.ListPolicyVersionsOptions) (*model.ListPolicyVersionsOutput, error)
 	ListGroups(ctx context.Context, options *model.ListGroupsOptions) (*model.ListGroupsOutput, error)
 	GetGroupByID(ctx context.Context, id string) (*model.GroupItem, error)
 	ListConnectedIntegrations(ctx context.Context, options *model.ListIntegrationsOptions) (*model.ListIntegrationsOutput, error)
 	GetIntegrationByID(ctx context.Context, id string) (*model.Integration, error)
 	ListComputers(ctx context.Context, options *model.ListComputersOptions) (*model.ListComputersOutput, error)
 	GetComputerByID(ctx context.Context, id string) (*model.Computer, error)
+	ListComputerApplications(ctx context.Context, computerID string, options *model.ListComputerApplicationsOptions) (*model.ListComputerApplicationsOutput, error)
 	ListVendors(ctx context.Context, options *model.ListVendorsOptions) (*model.ListVendorsOutput, error)
 	GetVendorByID(ctx context.Context, id string) (*model.Vendor, error)
+	CreateVendor(ctx context.Context, input *model.VendorInput) (*model.Vendor, error)
+	UpdateVendor(ctx context.Context, id string, input *model.VendorInput) (*model.Vendor, error)
+	SetVendorStatus(ctx context.Context, id, status string) (*model.Vendor, error)
+	SetVendorRiskAttributes(ctx context.Context, id string, riskAttributeIDs []string) (*model.Vendor, error)
+	ListVendorRiskAttributes(ctx context.Context, options *model.ListVendorRiskAttributesOptions) (*model.ListVendorRiskAttributesOutput, error)
+	ListVendorSecurityReviews(ctx context.Context, vendorID string, options *model.ListVendorSecurityReviewsOptions) (*model.ListVendorSecurityReviewsOutput, error)
+	ListVendorSecurityReviewDocuments(ctx context.Context, vendorID, securityReviewID string, options *model.ListVendorSecurityReviewDocumentsOptions) (*model.ListVendorSecurityReviewDocumentsOutput, error)
+	ListVendorFindings(ctx context.Context, vendorID string, options *model.ListVendorFindingsOptions) (*model.ListVendorFindingsOutput, error)
+	ListDiscoveredVendors(ctx context.Context, options *model.ListDiscoveredVendorsOptions) (*model.ListDiscoveredVendorsOutput, error)
+	GetTrustCenter(ctx context.Context, slugID string) (*model.TrustCenter, error)
+	ListTrustCenterDocuments(ctx context.Context, slugID string, options *model.ListTrustCenterDocumentsOptions) (*model.ListTrustCenterDocumentsOutput, error)
+	ListTrustCenterSubprocessors(ctx context.Context, slugID string, options *model.ListTrustCenterSubprocessorsOptions) (*model.ListTrustCenterSubprocessorsOutput, error)
+	ListTrustCenterAccessRequests(ctx context.Context, slugID string, options *model.ListTrustCenterAccessRequestsOptions) (*model.ListTrustCenterAccessRequestsOutput, error)
+	ApproveTrustCenterAccessRequest(ctx context.Context, slugID, accessRequestID string, input *model.ApproveTrustCenterAccessRequestInput) (*model.TrustCenterAccessRequest, error)
+	DenyTrustCenterAccessRequest(ctx context.Context, slugID, accessRequestID string, input *model.DenyTrustCenterAccessRequestInput) (*model.TrustCenterAccessRequest, error)
+	RevokeTrustCenterAccessRequest(ctx context.Context, slugID, accessRequestID string, input *model.RevokeTrustCenterAccessRequestInput) (*model.TrustCenterAccessRequest, error)
 	ListMonitors(ctx context.Context, options *model.ListMonitorsOptions) (*model.MonitorResults, error)
 	GetMonitorByID(ctx context.Context, id string) (*model.Monitor, error)
 	ListTestEntities(ctx context.Context, testID string, options *model.ListTestEntitiesOptions) (*model.TestEntitiesResults, error)
 
+	// Custom integration API methods
+	SyncCustomResources(ctx context.Context, integrationID, resourceKind string, resources []*model.CustomResource) (*model.CustomSubmissionOutput, error)
+	ReportCustomTestResults(ctx context.Context, testID string, results []*model.CustomTestResult) (*model.CustomSubmissionOutput, error)
+
 	// Comprehensive Test API methods
 	ListTests(ctx context.Context, options *model.ListTestsOptions) (*model.TestResults, error)
 	GetTestByID(ctx context.Context, id string) (*model.Test, error)
+	SetTestOwner(ctx context.Context, id string, input *model.SetTestOwnerInput) (*model.Test, error)
+	DeactivateTest(ctx context.Context, id string, input *model.DeactivateTestInput) (*model.Test, error)
+	ReactivateTest(ctx context.Context, id string) (*model.Test, error)
 
 	// Evidence API methods
 	ListEvidence(ctx context.Context, auditID string, options *model.ListEvidenceOptions) (*model.ListEvidenceOutput, error)
 
+	// Document API methods
+	UploadDocumentFile(ctx context.Context, documentID string, file io.Reader, filename string, effectiveDate time.Time) (*model.UploadedFile, error)
+	ListDocumentUploads(ctx context.Context, documentID string, options *model.ListDocumentUploadsOptions) (*model.ListDocumentUploadsOutput, error)
+	DeleteDocumentUpload(ctx context.Context, documentID, uploadedFileID string) error
+
 	// Vulnerability API methods
 	ListVulnerabilities(ctx context.Context, options *model.ListVulnerabilitiesOptions) (*model.ListVulnerabilitiesOutput, error)
 	GetVulnerabilityByID(ctx context.Context, id string) (*model.Vulnerability, error)
+	DeactivateVulnerabilities(ctx context.Context, inputs []*model.DeactivateVulnerabilityInput) (*model.UpdateVulnerabilitiesOutput, error)
+	ReactivateVulnerabilities(ctx context.Context, ids []string) (*model.UpdateVulnerabilitiesOutput, error)
+	OverrideVulnerabilitySLAs(ctx context.Context, inputs []*model.OverrideVulnerabilitySLAInput) (*model.UpdateVulnerabilitiesOutput, error)
 
 	SetHTTPClient(client *http.Client)
 }
@@ -58,6 +100,7 @@ type vanta struct {
 	clientID     string
 	clientSecret string
 	clientScopes []string
+	scopeMutex   sync.Mutex
 }
 
 // Option represents a functional option for configuring the client
@@ -68,7 +111,8 @@ func WithHTTPClient(httpClient *http.Client) Option {
 	return func(v *vanta) { v.httpClient = httpClient }
 }
 
-// WithScopes sets the OAuth scopes for the client
+// WithScopes sets the OAuth scopes for the client.
+// Write methods add ScopeAllWrite on first use, so read-only callers never request it.
 func WithScopes(scopes ...string) Option {
 	return func(v *vanta) { v.clientScopes = scopes }
 }
@@ -192,6 +236,30 @@ func (v *vanta) refreshToken(ctx context.Context) error {
 	return nil
 }
 
+// requireScope ensures the access token has been granted the given scope.
+// Tokens obtained with OAuth credentials are refreshed with the scope added; static tokens are used as-is.
+func (v *vanta) requireScope(ctx context.Context, scope string) error {
+	if _, ok := v.tokenStore.(*StaticTokenStore); !ok || v.clientID == "" {
+		return nil
+	}
+
+	v.scopeMutex.Lock()
+	defer v.scopeMutex.Unlock()
+
+	if slices.Contains(v.clientScopes, scope) {
+		return nil
+	}
+
+	previousScopes := v.clientScopes
+	v.clientScopes = append(slices.Clone(previousScopes), scope)
+	if err := v.refreshToken(ctx); err != nil {
+		v.clientScopes = previousScopes
+		return fmt.Errorf("failed to acquire auth token with scope %s: %v", scope, err)
+	}
+
+	return nil
+}
+
 // Implement the Vanta interface methods by delegating to RestClient methods
 func (v *vanta) ListPeople(ctx context.Context, options *model.ListPeopleOptions) (*model.ListPeopleOutput, error) {
 	client := &RestClient{
@@ -211,6 +279,71 @@ func (v *vanta) GetPersonByID(ctx context.Context, id string) (*model.Person, er
 	return client.GetPersonByID(ctx, id)
 }
 
+func (v *vanta) UpdatePersonEmployment(ctx context.Context, id string, employment *model.Employment) (*model.Person, error) {
+	if err := v.requireScope(ctx, ScopeAllWrite); err != nil {
+		return nil, err
+	}
+
+	client := &RestClient{
+		baseURL:    v.baseURL,
+		httpClient: v.httpClient,
+		tokenStore: v.tokenStore,
+	}
+	return client.UpdatePersonEmployment(ctx, id, employment)
+}
+
+func (v *vanta) MarkPersonOffboardingComplete(ctx context.Context, id string) (*model.Person, error) {
+	if err := v.requireScope(ctx, ScopeAllWrite); err != nil {
+		return nil, err
+	}
+
+	client := &RestClient{
+		baseURL:    v.baseURL,
+		httpClient: v.httpClient,
+		tokenStore: v.tokenStore,
+	}
+	return client.MarkPersonOffboardingComplete(ctx, id)
+}
+
+func (v *vanta) AssignPersonCustomTask(ctx context.Context, id string, input *model.CreateCustomTaskInput) (*model.CustomTask, error) {
+	if err := v.requireScope(ctx, ScopeAllWrite); err != nil {
+		return nil, err
+	}
+
+	client := &RestClient{
+		baseURL:    v.baseURL,
+		httpClient: v.httpClient,
+		tokenStore: v.tokenStore,
+	}
+	return client.AssignPersonCustomTask(ctx, id, input)
+}
+
+func (v *vanta) CompletePersonCustomTask(ctx context.Context, id, taskID string) (*model.Person, error) {
+	if err := v.requireScope(ctx, ScopeAllWrite); err != nil {
+		return nil, err
+	}
+
+	client := &RestClient{
+		baseURL:    v.baseURL,
+		httpClient: v.httpClient,
+		tokenStore: v.tokenStore,
+	}
+	return client.CompletePersonCustomTask(ctx, id, taskID)
+}
+
+func (v *vanta) AddPersonToGroup(ctx context.Context, id, groupID string) error {
+	if err := v.requireScope(ctx, ScopeAllWrite); err != nil {
+		return err
+	}
+
+	client := &RestClient{
+		baseURL:    v.baseURL,
+		httpClient: v.httpClient,
+		tokenStore: v.tokenStore,
+	}
+	return client.AddPersonToGroup(ctx, id, groupID)
+}
+
 func (v *vanta) ListPolicies(ctx context.Context, options *model.ListPoliciesOptions) (*model.ListPoliciesOutput, error) {
 	client := &RestClient{
 		baseURL:    v.baseURL,
@@ -229,6 +362,15 @@ func (v *vanta) GetPolicyByID(ctx context.Context, id string) (*model.PolicyItem
 	return client.GetPolicyByID(ctx, id)
 }
 
+func (v *vanta) ListPolicyVersions(ctx context.Context, policyID string, options *model.ListPolicyVersionsOptions) (*model.ListPolicyVersionsOutput, error) {
+	client := &RestClient{
+		baseURL:    v.baseURL,
+		httpClient: v.httpClient,
+		tokenStore: v.tokenStore,
+	}
+	return client.ListPolicyVersions(ctx, policyID, options)
+}
+
 func (v *vanta) ListGroups(ctx context.Context, options *model.ListGroupsOptions) (*model.ListGroupsOutput, error) {
 	client := &RestClient{
 		baseURL:    v.baseURL,
@@ -283,6 +425,15 @@ func (v *vanta) GetComputerByID(ctx context.Context, id string) (*model.Computer
 	return client.GetComputerByID(ctx, id)
 }
 
+func (v *vanta) ListComputerApplications(ctx context.Context, computerID string, options *model.ListComputerApplicationsOptions) (*model.ListComputerApplicationsOutput, error) {
+	client := &RestClient{
+		baseURL:    v.baseURL,
+		httpClient: v.httpClient,
+		tokenStore: v.tokenStore,
+	}
+	return client.ListComputerApplications(ctx, computerID, options)
+}
+
 func (v *vanta) ListVendors(ctx context.Context, options *model.ListVendorsOptions) (*model.ListVendorsOutput, error) {
 	client := &RestClient{
 		baseURL:    v.baseURL,
@@ -301,6 +452,178 @@ func (v *vanta) GetVendorByID(ctx context.Context, id string) (*model.Vendor, er
 	return client.GetVendorByID(ctx, id)
 }
 
+func (v *vanta) CreateVendor(ctx context.Context, input *model.VendorInput) (*model.Vendor, error) {
+	if err := v.requireScope(ctx, ScopeAllWrite); err != nil {
+		return nil, err
+	}
+
+	client := &RestClient{
+		baseURL:    v.baseURL,
+		httpClient: v.httpClient,
+		tokenStore: v.tokenStore,
+	}
+	return client.CreateVendor(ctx, input)
+}
+
+func (v *vanta) UpdateVendor(ctx context.Context, id string, input *model.VendorInput) (*model.Vendor, error) {
+	if err := v.requireScope(ctx, ScopeAllWrite); err != nil {
+		return nil, err
+	}
+
+	client := &RestClient{
+		baseURL:    v.baseURL,
+		httpClient: v.httpClient,
+		tokenStore: v.tokenStore,
+	}
+	return client.UpdateVendor(ctx, id, input)
+}
+
+func (v *vanta) SetVendorStatus(ctx context.Context, id, status string) (*model.Vendor, error) {
+	if err := v.requireScope(ctx, ScopeAllWrite); err != nil {
+		return nil, err
+	}
+
+	client := &RestClient{
+		baseURL:    v.baseURL,
+		httpClient: v.httpClient,
+		tokenStore: v.tokenStore,
+	}
+	return client.SetVendorStatus(ctx, id, status)
+}
+
+func (v *vanta) SetVendorRiskAttributes(ctx context.Context, id string, riskAttributeIDs []string) (*model.Vendor, error) {
+	if err := v.requireScope(ctx, ScopeAllWrite); err != nil {
+		return nil, err
+	}
+
+	client := &RestClient{
+		baseURL:    v.baseURL,
+		httpClient: v.httpClient,
+		tokenStore: v.tokenStore,
+	}
+	return client.SetVendorRiskAttributes(ctx, id, riskAttributeIDs)
+}
+
+func (v *vanta) ListVendorRiskAttributes(ctx context.Context, options *model.ListVendorRiskAttributesOptions) (*model.ListVendorRiskAttributesOutput, error) {
+	client := &RestClient{
+		baseURL:    v.baseURL,
+		httpClient: v.httpClient,
+		tokenStore: v.tokenStore,
+	}
+	return client.ListVendorRiskAttributes(ctx, options)
+}
+
+func (v *vanta) ListVendorSecurityReviews(ctx context.Context, vendorID string, options *model.ListVendorSecurityReviewsOptions) (*model.ListVendorSecurityReviewsOutput, error) {
+	client := &RestClient{
+		baseURL:    v.baseURL,
+		httpClient: v.httpClient,
+		tokenStore: v.tokenStore,
+	}
+	return client.ListVendorSecurityReviews(ctx, vendorID, options)
+}
+
+func (v *vanta) ListVendorSecurityReviewDocuments(ctx context.Context, vendorID, securityReviewID string, options *model.ListVendorSecurityReviewDocumentsOptions) (*model.ListVendorSecurityReviewDocumentsOutput, error) {
+	client := &RestClient{
+		baseURL:    v.baseURL,
+		httpClient: v.httpClient,
+		tokenStore: v.tokenStore,
+	}
+	return client.ListVendorSecurityReviewDocuments(ctx, vendorID, securityReviewID, options)
+}
+
+func (v *vanta) ListVendorFindings(ctx context.Context, vendorID string, options *model.ListVendorFindingsOptions) (*model.ListVendorFindingsOutput, error) {
+	client := &RestClient{
+		baseURL:    v.baseURL,
+		httpClient: v.httpClient,
+		tokenStore: v.tokenStore,
+	}
+	return client.ListVendorFindings(ctx, vendorID, options)
+}
+
+func (v *vanta) ListDiscoveredVendors(ctx context.Context, options *model.ListDiscoveredVendorsOptions) (*model.ListDiscoveredVendorsOutput, error) {
+	client := &RestClient{
+		baseURL:    v.baseURL,
+		httpClient: v.httpClient,
+		tokenStore: v.tokenStore,
+	}
+	return client.ListDiscoveredVendors(ctx, options)
+}
+
+func (v *vanta) GetTrustCenter(ctx context.Context, slugID string) (*model.TrustCenter, error) {
+	client := &RestClient{
+		baseURL:    v.baseURL,
+		httpClient: v.httpClient,
+		tokenStore: v.tokenStore,
+	}
+	return client.GetTrustCenter(ctx, slugID)
+}
+
+func (v *vanta) ListTrustCenterDocuments(ctx context.Context, slugID string, options *model.ListTrustCenterDocumentsOptions) (*model.ListTrustCenterDocumentsOutput, error) {
+	client := &RestClient{
+		baseURL:    v.baseURL,
+		httpClient: v.httpClient,
+		tokenStore: v.tokenStore,
+	}
+	return client.ListTrustCenterDocuments(ctx, slugID, options)
+}
+
+func (v *vanta) ListTrustCenterSubprocessors(ctx context.Context, slugID string, options *model.ListTrustCenterSubprocessorsOptions) (*model.ListTrustCenterSubprocessorsOutput, error) {
+	client := &RestClient{
+		baseURL:    v.baseURL,
+		httpClient: v.httpClient,
+		tokenStore: v.tokenStore,
+	}
+	return client.ListTrustCenterSubprocessors(ctx, slugID, options)
+}
+
+func (v *vanta) ListTrustCenterAccessRequests(ctx context.Context, slugID string, options *model.ListTrustCenterAccessRequestsOptions) (*model.ListTrustCenterAccessRequestsOutput, error) {
+	client := &RestClient{
+		baseURL:    v.baseURL,
+		httpClient: v.httpClient,
+		tokenStore: v.tokenStore,
+	}
+	return client.ListTrustCenterAccessRequests(ctx, slugID, options)
+}
+
+func (v *vanta) ApproveTrustCenterAccessRequest(ctx context.Context, slugID, accessRequestID string, input *model.ApproveTrustCenterAccessRequestInput) (*model.TrustCenterAccessRequest, error) {
+	if err := v.requireScope(ctx, ScopeAllWrite); err != nil {
+		return nil, err
+	}
+
+	client := &RestClient{
+		baseURL:    v.baseURL,
+		httpClient: v.httpClient,
+		tokenStore: v.tokenStore,
+	}
+	return client.ApproveTrustCenterAccessRequest(ctx, slugID, accessRequestID, input)
+}
+
+func (v *vanta) DenyTrustCenterAccessRequest(ctx context.Context, slugID, accessRequestID string, input *model.DenyTrustCenterAccessRequestInput) (*model.TrustCenterAccessRequest, error) {
+	if err := v.requireScope(ctx, ScopeAllWrite); err != nil {
+		return nil, err
+	}
+
+	client := &RestClient{
+		baseURL:    v.baseURL,
+		httpClient: v.httpClient,
+		tokenStore: v.tokenStore,
+	}
+	return client.DenyTrustCenterAccessRequest(ctx, slugID, accessRequestID, input)
+}
+
+func (v *vanta) RevokeTrustCenterAccessRequest(ctx context.Context, slugID, accessRequestID string, input *model.RevokeTrustCenterAccessRequestInput) (*model.TrustCenterAccessRequest, error) {
+	if err := v.requireScope(ctx, ScopeAllWrite); err != nil {
+		return nil, err
+	}
+
+	client := &RestClient{
+		baseURL:    v.baseURL,
+		httpClient: v.httpClient,
+		tokenStore: v.tokenStore,
+	}
+	return client.RevokeTrustCenterAccessRequest(ctx, slugID, accessRequestID, input)
+}
+
 func (v *vanta) ListMonitors(ctx context.Context, options *model.ListMonitorsOptions) (*model.MonitorResults, error) {
 	client := &RestClient{
 		baseURL:    v.baseURL,
@@ -328,6 +651,32 @@ func (v *vanta) ListTestEntities(ctx context.Context, testID string, options *mo
 	return client.ListTestEntities(ctx, testID, options)
 }
 
+func (v *vanta) SyncCustomResources(ctx context.Context, integrationID, resourceKind string, resources []*model.CustomResource) (*model.CustomSubmissionOutput, error) {
+	if err := v.requireScope(ctx, ScopeAllWrite); err != nil {
+		return nil, err
+	}
+
+	client := &RestClient{
+		baseURL:    v.baseURL,
+		httpClient: v.httpClient,
+		tokenStore: v.tokenStore,
+	}
+	return client.SyncCustomResources(ctx, integrationID, resourceKind, resources)
+}
+
+func (v *vanta) ReportCustomTestResults(ctx context.Context, testID string, results []*model.CustomTestResult) (*model.CustomSubmissionOutput, error) {
+	if err := v.requireScope(ctx, ScopeAllWrite); err != nil {
+		return nil, err
+	}
+
+	client := &RestClient{
+		baseURL:    v.baseURL,
+		httpClient: v.httpClient,
+		tokenStore: v.tokenStore,
+	}
+	return client.ReportCustomTestResults(ctx, testID, results)
+}
+
 // Comprehensive Test API method implementations
 func (v *vanta) ListTests(ctx context.Context, options *model.ListTestsOptions) (*model.TestResults, error) {
 	client := &RestClient{
@@ -347,6 +696,45 @@ func (v *vanta) GetTestByID(ctx context.Context, id string) (*model.Test, error)
 	return client.GetTestByID(ctx, id)
 }
 
+func (v *vanta) SetTestOwner(ctx context.Context, id string, input *model.SetTestOwnerInput) (*model.Test, error) {
+	if err := v.requireScope(ctx, ScopeAllWrite); err != nil {
+		return nil, err
+	}
+
+	client := &RestClient{
+		baseURL:    v.baseURL,
+		httpClient: v.httpClient,
+		tokenStore: v.tokenStore,
+	}
+	return client.SetTestOwner(ctx, id, input)
+}
+
+func (v *vanta) DeactivateTest(ctx context.Context, id string, input *model.DeactivateTestInput) (*model.Test, error) {
+	if err := v.requireScope(ctx, ScopeAllWrite); err != nil {
+		return nil, err
+	}
+
+	client := &RestClient{
+		baseURL:    v.baseURL,
+		httpClient: v.httpClient,
+		tokenStore: v.tokenStore,
+	}
+	return client.DeactivateTest(ctx, id, input)
+}
+
+func (v *vanta) ReactivateTest(ctx context.Context, id string) (*model.Test, error) {
+	if err := v.requireScope(ctx, ScopeAllWrite); err != nil {
+		return nil, err
+	}
+
+	client := &RestClient{
+		baseURL:    v.baseURL,
+		httpClient: v.httpClient,
+		tokenStore: v.tokenStore,
+	}
+	return client.ReactivateTest(ctx, id)
+}
+
 func (v *vanta) ListEvidence(ctx context.Context, auditID string, options *model.ListEvidenceOptions) (*model.ListEvidenceOutput, error) {
 	client := &RestClient{
 		baseURL:    v.baseURL,
@@ -356,6 +744,41 @@ func (v *vanta) ListEvidence(ctx context.Context, auditID string, options *model
 	return client.ListEvidence(ctx, auditID, options)
 }
 
+func (v *vanta) UploadDocumentFile(ctx context.Context, documentID string, file io.Reader, filename string, effectiveDate time.Time) (*model.UploadedFile, error) {
+	if err := v.requireScope(ctx, ScopeAllWrite); err != nil {
+		return nil, err
+	}
+
+	client := &RestClient{
+		baseURL:    v.baseURL,
+		httpClient: v.httpClient,
+		tokenStore: v.tokenStore,
+	}
+	return client.UploadDocumentFile(ctx, documentID, file, filename, effectiveDate)
+}
+
+func (v *vanta) ListDocumentUploads(ctx context.Context, documentID string, options *model.ListDocumentUploadsOptions) (*model.ListDocumentUploadsOutput, error) {
+	client := &RestClient{
+		baseURL:    v.baseURL,
+		httpClient: v.httpClient,
+		tokenStore: v.tokenStore,
+	}
+	return client.ListDocumentUploads(ctx, documentID, options)
+}
+
+func (v *vanta) DeleteDocumentUpload(ctx context.Context, documentID, uploadedFileID string) error {
+	if err := v.requireScope(ctx, ScopeAllWrite); err != nil {
+		return err
+	}
+
+	client := &RestClient{
+		baseURL:    v.baseURL,
+		httpClient: v.httpClient,
+		tokenStore: v.tokenStore,
+	}
+	return client.DeleteDocumentUpload(ctx, documentID, uploadedFileID)
+}
+
 func (v *vanta) SetHTTPClient(client *http.Client) {
 	v.httpClient = client
 }
@@ -377,3 +800,42 @@ func (v *vanta) GetVulnerabilityByID(ctx context.Context, id string) (*model.Vul
 	}
 	return client.GetVulnerabilityByID(ctx, id)
 }
+
+func (v *vanta) DeactivateVulnerabilities(ctx context.Context, inputs []*model.DeactivateVulnerabilityInput) (*model.UpdateVulnerabilitiesOutput, error) {
+	if err := v.requireScope(ctx, ScopeAllWrite); err != nil {
+		return nil, err
+	}
+
+	client := &RestClient{
+		baseURL:    v.baseURL,
+		httpClient: v.httpClient,
+		tokenStore: v.tokenStore,
+	}
+	return client.DeactivateVulnerabilities(ctx, inputs)
+}
+
+func (v *vanta) ReactivateVulnerabilities(ctx context.Context, ids []string) (*model.UpdateVulnerabilitiesOutput, error) {
+	if err := v.requireScope(ctx, ScopeAllWrite); err != nil {
+		return nil, err
+	}
+
+	client := &RestClient{
+		baseURL:    v.baseURL,
+		httpClient: v.httpClient,
+		tokenStore: v.tokenStore,
+	}
+	return client.ReactivateVulnerabilities(ctx, ids)
+}
+
+func (v *vanta) OverrideVulnerabilitySLAs(ctx context.Context, inputs []*model.OverrideVulnerabilitySLAInput) (*model.UpdateVulnerabilitiesOutput, error) {
+	if err := v.requireScope(ctx, ScopeAllWrite); err != nil {
+		return nil, err
+	}
+
+	client := &RestClient{
+		baseURL:    v.baseURL,
+		httpClient: v.httpClient,
+		tokenStore: v.tokenStore,
+	}
+	return client.OverrideVulnerabilitySLAs(ctx, inputs)
+}
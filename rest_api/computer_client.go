@@ -21,9 +21,30 @@ func (c *RestClient) ListComputers(ctx context.Context, options *model.ListCompu
 		if options.Cursor != "" {
 			params.Set("pageCursor", options.Cursor)
 		}
+		if options.OwnerID != "" {
+			params.Set("ownerId", options.OwnerID)
+		}
+		if options.OperatingSystemType != "" {
+			params.Set("operatingSystemType", options.OperatingSystemType)
+		}
+		if options.IntegrationID != "" {
+			params.Set("integrationId", options.IntegrationID)
+		}
+		if options.ScreenlockOutcome != "" {
+			params.Set("screenlockOutcome", options.ScreenlockOutcome)
+		}
+		if options.DiskEncryptionOutcome != "" {
+			params.Set("diskEncryptionOutcome", options.DiskEncryptionOutcome)
+		}
+		if options.PasswordManagerOutcome != "" {
+			params.Set("passwordManagerOutcome", options.PasswordManagerOutcome)
+		}
+		if options.AntivirusInstallationOutcome != "" {
+			params.Set("antivirusInstallationOutcome", options.AntivirusInstallationOutcome)
+		}
 	}
 
-	resp, err := c.makeRequest(ctx, "GET", "/v1/monitored-computers", params)
+	resp, err := c.makeRequest(ctx, "GET", "/v1/monitored-computers", params, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make request: %w", err)
 	}
@@ -47,7 +68,7 @@ func (c *RestClient) GetComputerByID(ctx context.Context, id string) (*model.Com
 		return nil, fmt.Errorf("computer ID cannot be empty")
 	}
 
-	resp, err := c.makeRequest(ctx, "GET", fmt.Sprintf("/v1/monitored-computers/%s", id), nil)
+	resp, err := c.makeRequest(ctx, "GET", fmt.Sprintf("/v1/monitored-computers/%s", id), nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make request: %w", err)
 	}
@@ -64,3 +85,39 @@ func (c *RestClient) GetComputerByID(ctx context.Context, id string) (*model.Com
 
 	return computer, nil
 }
+
+// ListComputerApplications retrieves a paginated list of applications installed on a specific computer
+func (c *RestClient) ListComputerApplications(ctx context.Context, computerID string, options *model.ListComputerApplicationsOptions) (*model.ListComputerApplicationsOutput, error) {
+	if computerID == "" {
+		return nil, fmt.Errorf("computer ID cannot be empty")
+	}
+
+	// Build URL with query parameters
+	params := url.Values{}
+
+	if options != nil {
+		if options.Limit > 0 {
+			params.Set("pageSize", fmt.Sprintf("%d", options.Limit))
+		}
+		if options.Cursor != "" {
+			params.Set("pageCursor", options.Cursor)
+		}
+	}
+
+	resp, err := c.makeRequest(ctx, "GET", fmt.Sprintf("/v1/monitored-computers/%s/applications", computerID), params, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+
+	respBodyBytes, err := c.readResponseBody(resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result *model.ListComputerApplicationsOutput
+	if err = json.Unmarshal(respBodyBytes, &result); err != nil {
+		return nil, fmt.Errorf("failed to JSON-decode response body: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,63 @@
+package rest_api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-vanta/v2/rest_api/model"
+)
+
+// SyncCustomResources replaces the resources of the given resource kind in a custom integration with the given set.
+// Resources are created or updated by resource ID, and existing resources missing from the set are removed,
+// so the full set is sent in a single request; the outcome of each resource is reported in the output.
+// A nil slice is rejected to guard against accidentally deleting every resource; pass a non-nil empty slice to remove them all.
+func (c *RestClient) SyncCustomResources(ctx context.Context, integrationID, resourceKind string, resources []*model.CustomResource) (*model.CustomSubmissionOutput, error) {
+	if integrationID == "" {
+		return nil, fmt.Errorf("integration ID cannot be empty")
+	}
+	if resourceKind == "" {
+		return nil, fmt.Errorf("resource kind cannot be empty")
+	}
+
+	if resources == nil {
+		return nil, fmt.Errorf("resources cannot be nil; pass an empty slice to remove all resources of the kind")
+	}
+	for _, resource := range resources {
+		if resource == nil || resource.ResourceID == "" {
+			return nil, fmt.Errorf("resource ID cannot be empty")
+		}
+	}
+
+	var output *model.CustomSubmissionOutput
+	path := fmt.Sprintf("/v1/integrations/%s/resource-kinds/%s/resources", integrationID, resourceKind)
+	if err := c.doJSON(ctx, "PUT", path, map[string]interface{}{"resources": resources}, &output); err != nil {
+		return nil, err
+	}
+	if output == nil {
+		output = &model.CustomSubmissionOutput{}
+	}
+
+	return output, nil
+}
+
+// ReportCustomTestResults reports the outcome of a custom test for resources pushed through a custom integration.
+// Each result creates or updates the outcome for its resource, leaving the outcomes of other resources untouched,
+// so results are sent in batches; the outcome of each submission is reported in the output.
+func (c *RestClient) ReportCustomTestResults(ctx context.Context, testID string, results []*model.CustomTestResult) (*model.CustomSubmissionOutput, error) {
+	if testID == "" {
+		return nil, fmt.Errorf("test ID cannot be empty")
+	}
+
+	items := make([]interface{}, 0, len(results))
+	for _, result := range results {
+		if result == nil || result.ResourceID == "" {
+			return nil, fmt.Errorf("resource ID cannot be empty")
+		}
+		if result.Outcome == "" {
+			return nil, fmt.Errorf("outcome cannot be empty for resource %s", result.ResourceID)
+		}
+		items = append(items, result)
+	}
+
+	return sendBatches[*model.CustomSubmissionResult](ctx, c, "POST", fmt.Sprintf("/v1/tests/%s/results", testID), "results", items)
+}
@@ -0,0 +1,96 @@
+package rest_api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-vanta/v2/rest_api/model"
+)
+
+// UploadDocumentFile uploads a file as evidence for a document.
+// The file is streamed from the reader; effectiveDate is the date the evidence applies from, or now if zero.
+func (c *RestClient) UploadDocumentFile(ctx context.Context, documentID string, file io.Reader, filename string, effectiveDate time.Time) (*model.UploadedFile, error) {
+	if documentID == "" {
+		return nil, fmt.Errorf("document ID cannot be empty")
+	}
+	if file == nil {
+		return nil, fmt.Errorf("file cannot be nil")
+	}
+	if filename == "" {
+		return nil, fmt.Errorf("filename cannot be empty")
+	}
+
+	fields := map[string]string{}
+	if !effectiveDate.IsZero() {
+		fields["effectiveAtDate"] = effectiveDate.UTC().Format("2006-01-02T15:04:05.000Z")
+	}
+
+	resp, err := c.makeMultipartRequest(ctx, "POST", fmt.Sprintf("/v1/documents/%s/uploads", documentID), fields, "file", filename, file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+
+	respBodyBytes, err := c.readResponseBody(resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var uploadedFile *model.UploadedFile
+	if err = json.Unmarshal(respBodyBytes, &uploadedFile); err != nil {
+		return nil, fmt.Errorf("failed to JSON-decode response body: %w", err)
+	}
+
+	return uploadedFile, nil
+}
+
+// ListDocumentUploads retrieves a paginated list of files uploaded to a document
+func (c *RestClient) ListDocumentUploads(ctx context.Context, documentID string, options *model.ListDocumentUploadsOptions) (*model.ListDocumentUploadsOutput, error) {
+	if documentID == "" {
+		return nil, fmt.Errorf("document ID cannot be empty")
+	}
+
+	// Build URL with query parameters
+	params := url.Values{}
+
+	if options != nil {
+		if options.Limit > 0 {
+			params.Set("pageSize", fmt.Sprintf("%d", options.Limit))
+		}
+		if options.Cursor != "" {
+			params.Set("pageCursor", options.Cursor)
+		}
+	}
+
+	resp, err := c.makeRequest(ctx, "GET", fmt.Sprintf("/v1/documents/%s/uploads", documentID), params, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+
+	respBodyBytes, err := c.readResponseBody(resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result *model.ListDocumentUploadsOutput
+	if err = json.Unmarshal(respBodyBytes, &result); err != nil {
+		return nil, fmt.Errorf("failed to JSON-decode response body: %w", err)
+	}
+
+	return result, nil
+}
+
+// DeleteDocumentUpload deletes a file previously uploaded to a document
+func (c *RestClient) DeleteDocumentUpload(ctx context.Context, documentID, uploadedFileID string) error {
+	if documentID == "" {
+		return fmt.Errorf("document ID cannot be empty")
+	}
+	if uploadedFileID == "" {
+		return fmt.Errorf("uploaded file ID cannot be empty")
+	}
+
+	return c.delete(ctx, fmt.Sprintf("/v1/documents/%s/uploads/%s", documentID, uploadedFileID))
+}
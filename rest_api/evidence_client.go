@@ -28,7 +28,7 @@ func (c *RestClient) ListEvidence(ctx context.Context, auditID string, options *
 		}
 	}
 
-	resp, err := c.makeRequest(ctx, "GET", path, queryParams)
+	resp, err := c.makeRequest(ctx, "GET", path, queryParams, nil)
 	if err != nil {
 		return nil, err
 	}
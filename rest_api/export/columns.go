@@ -0,0 +1,208 @@
+// Package export writes rest_api models to CSV and Parquet files for loading into
+// spreadsheets and analytical databases such as DuckDB.
+//
+// Columns are derived from the JSON tags of the model by reflection. Nested structs,
+// such as the employment of a person, are flattened into dotted columns ("employment.jobTitle"),
+// and slices, maps and interfaces are written as JSON. Columns follow the order in which
+// fields are declared, so the layout of a file only changes when the model does.
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+// kind is the type of the values of a column
+type kind int
+
+const (
+	kindString kind = iota
+	kindBool
+	kindInt
+	kindFloat
+	kindTime
+	kindJSON
+)
+
+// column is a flattened field of a model
+type column struct {
+	name  string
+	kind  kind
+	index []int // Field index path from the record, following pointers
+}
+
+var (
+	timeType      = reflect.TypeFor[time.Time]()
+	marshalerType = reflect.TypeFor[json.Marshaler]()
+
+	// columnCache holds the columns of the record types seen so far
+	columnCache sync.Map // map[reflect.Type][]*column
+)
+
+// Columns returns the names of the columns written for records of type T, in file order
+func Columns[T any]() ([]string, error) {
+	columns, err := getColumns(reflect.TypeFor[T]())
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, len(columns))
+	for i, c := range columns {
+		names[i] = c.name
+	}
+	return names, nil
+}
+
+// getColumns returns the columns of a record type, which must be a struct or a pointer to one
+func getColumns(t reflect.Type) ([]*column, error) {
+	if cached, ok := columnCache.Load(t); ok {
+		return cached.([]*column), nil
+	}
+
+	st := t
+	for st.Kind() == reflect.Pointer {
+		st = st.Elem()
+	}
+	if st.Kind() != reflect.Struct || st == timeType {
+		return nil, fmt.Errorf("unsupported record type %s: must be a struct", t)
+	}
+
+	columns := appendColumns(nil, st, "", nil, map[reflect.Type]bool{})
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("record type %s has no exported fields", t)
+	}
+
+	columnCache.Store(t, columns)
+	return columns, nil
+}
+
+// appendColumns appends the columns of the fields of struct type t.
+// Types already being flattened are written as JSON, so recursive models terminate.
+func appendColumns(columns []*column, t reflect.Type, prefix string, index []int, visiting map[reflect.Type]bool) []*column {
+	visiting[t] = true
+	defer delete(visiting, t)
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+
+		fieldIndex := append(append([]int{}, index...), i)
+		ft := field.Type
+		for ft.Kind() == reflect.Pointer {
+			ft = ft.Elem()
+		}
+
+		// Embedded structs without a name are flattened into the parent, as encoding/json does
+		if field.Anonymous && name == "" {
+			if ft.Kind() == reflect.Struct && !visiting[ft] {
+				columns = appendColumns(columns, ft, prefix, fieldIndex, visiting)
+			}
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		name = prefix + name
+
+		// Field names shadowed by a field declared earlier are skipped
+		if hasColumn(columns, name) {
+			continue
+		}
+
+		k := kindOf(ft)
+		if k == kindJSON && ft.Kind() == reflect.Struct && !ft.Implements(marshalerType) && !visiting[ft] {
+			columns = appendColumns(columns, ft, name+".", fieldIndex, visiting)
+			continue
+		}
+		columns = append(columns, &column{name: name, kind: k, index: fieldIndex})
+	}
+
+	return columns
+}
+
+// hasColumn reports whether a column with the given name exists
+func hasColumn(columns []*column, name string) bool {
+	for _, c := range columns {
+		if c.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// kindOf returns the column kind of a non-pointer field type
+func kindOf(t reflect.Type) kind {
+	if t == timeType {
+		return kindTime
+	}
+	if t.Implements(marshalerType) {
+		return kindJSON
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return kindString
+	case reflect.Bool:
+		return kindBool
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return kindInt
+	case reflect.Float32, reflect.Float64:
+		return kindFloat
+	default:
+		return kindJSON
+	}
+}
+
+// value returns the value of the column in a record, or false if it is null
+func (c *column) value(record reflect.Value) (reflect.Value, bool) {
+	v := record
+	for _, i := range c.index {
+		for v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				return reflect.Value{}, false
+			}
+			v = v.Elem()
+		}
+		v = v.Field(i)
+	}
+
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return reflect.Value{}, false
+		}
+		if c.kind == kindJSON && v.Kind() == reflect.Interface {
+			break
+		}
+		v = v.Elem()
+	}
+	if c.kind == kindJSON && (v.Kind() == reflect.Slice || v.Kind() == reflect.Map) && v.IsNil() {
+		return reflect.Value{}, false
+	}
+
+	return v, true
+}
+
+// jsonValue JSON-encodes the value of a JSON column, or returns false if it encodes to null
+func jsonValue(v reflect.Value) ([]byte, bool, error) {
+	data, err := json.Marshal(v.Interface())
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to JSON-encode value: %w", err)
+	}
+	if string(data) == "null" {
+		return nil, false, nil
+	}
+	return data, true, nil
+}
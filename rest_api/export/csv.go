@@ -0,0 +1,85 @@
+package export
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"iter"
+	"reflect"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// WriteCSV writes records to w as CSV, with a header row of column names.
+// Null values are written as empty fields and timestamps in RFC 3339 format.
+func WriteCSV[T any](w io.Writer, records []T) error {
+	return WriteCSVSeq(w, slices.Values(records))
+}
+
+// WriteCSVSeq writes the records of a sequence to w as CSV, with a header row of column names
+func WriteCSVSeq[T any](w io.Writer, records iter.Seq[T]) error {
+	columns, err := getColumns(reflect.TypeFor[T]())
+	if err != nil {
+		return err
+	}
+
+	writer := csv.NewWriter(w)
+
+	row := make([]string, len(columns))
+	for i, c := range columns {
+		row[i] = c.name
+	}
+	if err = writer.Write(row); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	for record := range records {
+		rv := reflect.ValueOf(&record).Elem()
+		for i, c := range columns {
+			if row[i], err = csvValue(c, rv); err != nil {
+				return fmt.Errorf("failed to write column %s: %w", c.name, err)
+			}
+		}
+		if err = writer.Write(row); err != nil {
+			return fmt.Errorf("failed to write CSV record: %w", err)
+		}
+	}
+
+	writer.Flush()
+	if err = writer.Error(); err != nil {
+		return fmt.Errorf("failed to write CSV: %w", err)
+	}
+
+	return nil
+}
+
+// csvValue formats the value of a column in a record as a CSV field
+func csvValue(c *column, record reflect.Value) (string, error) {
+	v, ok := c.value(record)
+	if !ok {
+		return "", nil
+	}
+
+	switch c.kind {
+	case kindString:
+		return v.String(), nil
+	case kindBool:
+		return strconv.FormatBool(v.Bool()), nil
+	case kindInt:
+		if v.CanUint() {
+			return strconv.FormatUint(v.Uint(), 10), nil
+		}
+		return strconv.FormatInt(v.Int(), 10), nil
+	case kindFloat:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64), nil
+	case kindTime:
+		return v.Interface().(time.Time).Format(time.RFC3339Nano), nil
+	default:
+		data, ok, err := jsonValue(v)
+		if err != nil || !ok {
+			return "", err
+		}
+		return string(data), nil
+	}
+}
@@ -0,0 +1,155 @@
+package export
+
+import (
+	"fmt"
+	"io"
+	"iter"
+	"reflect"
+	"slices"
+	"time"
+
+	"github.com/parquet-go/parquet-go"
+)
+
+// parquetBatchSize is the number of rows buffered before they are passed to the Parquet writer
+const parquetBatchSize = 1000
+
+// WriteParquet writes records to w as a Parquet file.
+// All columns are optional; timestamps are written with microsecond precision and JSON columns with the JSON logical type.
+// Options such as parquet.Compression are passed on to the Parquet writer.
+func WriteParquet[T any](w io.Writer, records []T, options ...parquet.WriterOption) error {
+	return WriteParquetSeq(w, slices.Values(records), options...)
+}
+
+// WriteParquetSeq writes the records of a sequence to w as a Parquet file
+func WriteParquetSeq[T any](w io.Writer, records iter.Seq[T], options ...parquet.WriterOption) error {
+	t := reflect.TypeFor[T]()
+	columns, err := getColumns(t)
+	if err != nil {
+		return err
+	}
+
+	schema := parquetSchema(t, columns)
+	writer := parquet.NewWriter(w, append([]parquet.WriterOption{schema}, options...)...)
+
+	rows := make([]parquet.Row, 0, parquetBatchSize)
+	for record := range records {
+		rv := reflect.ValueOf(&record).Elem()
+		row := make(parquet.Row, len(columns))
+		for i, c := range columns {
+			if row[i], err = parquetValue(c, rv); err != nil {
+				return fmt.Errorf("failed to write column %s: %w", c.name, err)
+			}
+			row[i] = row[i].Level(0, definitionLevel(row[i]), i)
+		}
+
+		if rows = append(rows, row); len(rows) == parquetBatchSize {
+			if _, err = writer.WriteRows(rows); err != nil {
+				return fmt.Errorf("failed to write Parquet rows: %w", err)
+			}
+			rows = rows[:0]
+		}
+	}
+
+	if _, err = writer.WriteRows(rows); err != nil {
+		return fmt.Errorf("failed to write Parquet rows: %w", err)
+	}
+	if err = writer.Close(); err != nil {
+		return fmt.Errorf("failed to write Parquet file: %w", err)
+	}
+
+	return nil
+}
+
+// parquetSchema builds a flat schema of optional columns, in column order
+func parquetSchema(t reflect.Type, columns []*column) *parquet.Schema {
+	group := orderedGroup{Group: parquet.Group{}}
+	for _, c := range columns {
+		var node parquet.Node
+		switch c.kind {
+		case kindString:
+			node = parquet.String()
+		case kindBool:
+			node = parquet.Leaf(parquet.BooleanType)
+		case kindInt:
+			node = parquet.Int(64)
+		case kindFloat:
+			node = parquet.Leaf(parquet.DoubleType)
+		case kindTime:
+			node = parquet.Timestamp(parquet.Microsecond)
+		default:
+			node = parquet.JSON()
+		}
+		node = parquet.Optional(node)
+
+		group.Group[c.name] = node
+		group.fields = append(group.fields, &groupField{Node: node, name: c.name})
+	}
+
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return parquet.NewSchema(t.Name(), group)
+}
+
+// parquetValue converts the value of a column in a record to a Parquet value
+func parquetValue(c *column, record reflect.Value) (parquet.Value, error) {
+	v, ok := c.value(record)
+	if !ok {
+		return parquet.NullValue(), nil
+	}
+
+	switch c.kind {
+	case kindString:
+		return parquet.ByteArrayValue([]byte(v.String())), nil
+	case kindBool:
+		return parquet.BooleanValue(v.Bool()), nil
+	case kindInt:
+		if v.CanUint() {
+			return parquet.Int64Value(int64(v.Uint())), nil
+		}
+		return parquet.Int64Value(v.Int()), nil
+	case kindFloat:
+		return parquet.DoubleValue(v.Float()), nil
+	case kindTime:
+		return parquet.Int64Value(v.Interface().(time.Time).UnixMicro()), nil
+	default:
+		data, ok, err := jsonValue(v)
+		if err != nil || !ok {
+			return parquet.NullValue(), err
+		}
+		return parquet.ByteArrayValue(data), nil
+	}
+}
+
+// definitionLevel returns the definition level of a value in an optional top-level column
+func definitionLevel(v parquet.Value) int {
+	if v.IsNull() {
+		return 0
+	}
+	return 1
+}
+
+// orderedGroup is a parquet.Group whose fields keep the order of the columns,
+// rather than being sorted by name
+type orderedGroup struct {
+	parquet.Group
+	fields []parquet.Field
+}
+
+// Fields returns the fields of the group in column order
+func (g orderedGroup) Fields() []parquet.Field { return g.fields }
+
+// groupField is a named field of an orderedGroup
+type groupField struct {
+	parquet.Node
+	name string
+}
+
+// Name returns the name of the field
+func (f *groupField) Name() string { return f.name }
+
+// Value returns the value of the field in a map of column values
+func (f *groupField) Value(base reflect.Value) reflect.Value {
+	return base.MapIndex(reflect.ValueOf(f.name))
+}
@@ -23,7 +23,7 @@ func (c *RestClient) ListGroups(ctx context.Context, options *model.ListGroupsOp
 		}
 	}
 
-	resp, err := c.makeRequest(ctx, "GET", "/v1/groups", params)
+	resp, err := c.makeRequest(ctx, "GET", "/v1/groups", params, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make request: %w", err)
 	}
@@ -47,7 +47,7 @@ func (c *RestClient) GetGroupByID(ctx context.Context, id string) (*model.GroupI
 		return nil, fmt.Errorf("group ID cannot be empty")
 	}
 
-	resp, err := c.makeRequest(ctx, "GET", fmt.Sprintf("/v1/groups/%s", id), nil)
+	resp, err := c.makeRequest(ctx, "GET", fmt.Sprintf("/v1/groups/%s", id), nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make request: %w", err)
 	}
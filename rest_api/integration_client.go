@@ -23,7 +23,7 @@ func (c *RestClient) ListConnectedIntegrations(ctx context.Context, options *mod
 		}
 	}
 
-	resp, err := c.makeRequest(ctx, "GET", "/v1/integrations", params)
+	resp, err := c.makeRequest(ctx, "GET", "/v1/integrations", params, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make request: %w", err)
 	}
@@ -47,7 +47,7 @@ func (c *RestClient) GetIntegrationByID(ctx context.Context, id string) (*model.
 		return nil, fmt.Errorf("integration ID cannot be empty")
 	}
 
-	resp, err := c.makeRequest(ctx, "GET", fmt.Sprintf("/v1/integrations/%s", id), nil)
+	resp, err := c.makeRequest(ctx, "GET", fmt.Sprintf("/v1/integrations/%s", id), nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make request: %w", err)
 	}
@@ -1,5 +1,7 @@
 package model
 
+import "encoding/json"
+
 type PageInfo struct {
 	HasPreviousPage bool   `json:"hasPreviousPage"`
 	HasNextPage     bool   `json:"hasNextPage"`
@@ -10,3 +12,95 @@ type PageInfo struct {
 type GenericNamedItem struct {
 	Name string `json:"name"`
 }
+
+// CustomField represents a user-defined field attached to a Vanta resource
+type CustomField struct {
+	Label string          `json:"label"`
+	Type  CustomFieldType `json:"type"`
+	Value interface{}     `json:"value"`
+}
+
+type CustomFieldType string
+
+const (
+	CustomFieldTypeText         CustomFieldType = "TEXT"
+	CustomFieldTypeNumber       CustomFieldType = "NUMBER"
+	CustomFieldTypeDate         CustomFieldType = "DATE"
+	CustomFieldTypeBoolean      CustomFieldType = "BOOLEAN"
+	CustomFieldTypeSingleSelect CustomFieldType = "SINGLE_SELECT"
+	CustomFieldTypeMultiSelect  CustomFieldType = "MULTI_SELECT"
+)
+
+// Nullable represents an optional field of a write request that distinguishes an unset value,
+// which is omitted from the request, from an explicit null, which clears the field.
+// Fields of this type must be tagged with omitzero.
+type Nullable[T any] struct {
+	Value T
+	Set   bool
+	Null  bool
+}
+
+// NewNullable returns a Nullable set to the given value
+func NewNullable[T any](value T) Nullable[T] {
+	return Nullable[T]{Value: value, Set: true}
+}
+
+// Null returns a Nullable explicitly set to null
+func Null[T any]() Nullable[T] {
+	return Nullable[T]{Set: true, Null: true}
+}
+
+// IsZero reports whether the value is unset, so that omitzero omits it
+func (n Nullable[T]) IsZero() bool {
+	return !n.Set
+}
+
+// MarshalJSON encodes the value, or null if explicitly set to null
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	if n.Null {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.Value)
+}
+
+// UnmarshalJSON decodes the value, recording an explicit null
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	n.Set = true
+	if string(data) == "null" {
+		n.Null = true
+		return nil
+	}
+	return json.Unmarshal(data, &n.Value)
+}
+
+// BatchItemResult is implemented by the per-item results of batch write requests
+type BatchItemResult interface {
+	Succeeded() bool
+}
+
+// BatchItemStatus contains the outcome of a single item of a batch write request
+type BatchItemStatus struct {
+	Success bool   `json:"success"`
+	Error   string `json:"error,omitempty"`
+}
+
+// Succeeded reports whether the item was applied
+func (s BatchItemStatus) Succeeded() bool {
+	return s.Success
+}
+
+// BatchOutput contains the outcome of a batch write request, one result per item
+type BatchOutput[R BatchItemResult] struct {
+	Results []R `json:"results"`
+}
+
+// Failed returns the results of the items that were rejected
+func (o *BatchOutput[R]) Failed() []R {
+	var failed []R
+	for _, result := range o.Results {
+		if !result.Succeeded() {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
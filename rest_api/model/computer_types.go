@@ -6,8 +6,15 @@ import (
 
 // ListComputersOptions represents options for listing computers
 type ListComputersOptions struct {
-	Limit  int    `json:"limit,omitempty"`
-	Cursor string `json:"cursor,omitempty"`
+	Limit                        int    `json:"limit,omitempty"`
+	Cursor                       string `json:"cursor,omitempty"`
+	OwnerID                      string `json:"ownerId,omitempty"`                      // Filter by owner ID
+	OperatingSystemType          string `json:"operatingSystemType,omitempty"`          // MACOS, WINDOWS, LINUX
+	IntegrationID                string `json:"integrationId,omitempty"`                // Filter by integration (e.g., "kandji")
+	ScreenlockOutcome            string `json:"screenlockOutcome,omitempty"`            // PASS, FAIL, NOT_APPLICABLE
+	DiskEncryptionOutcome        string `json:"diskEncryptionOutcome,omitempty"`        // PASS, FAIL, NOT_APPLICABLE
+	PasswordManagerOutcome       string `json:"passwordManagerOutcome,omitempty"`       // PASS, FAIL, NOT_APPLICABLE
+	AntivirusInstallationOutcome string `json:"antivirusInstallationOutcome,omitempty"` // PASS, FAIL, NOT_APPLICABLE
 }
 
 // ListComputersOutput represents the response from the list computers API
@@ -69,5 +76,30 @@ type OperatingSystem struct {
 
 // SecurityCheck represents the outcome of a security check
 type SecurityCheck struct {
-	Outcome string `json:"outcome"`
+	Outcome       string     `json:"outcome"`
+	LastCheckDate *time.Time `json:"lastCheckDate,omitempty"`
+}
+
+// ListComputerApplicationsOptions represents options for listing the applications installed on a computer
+type ListComputerApplicationsOptions struct {
+	Limit  int    `json:"limit,omitempty"`
+	Cursor string `json:"cursor,omitempty"`
+}
+
+// ListComputerApplicationsOutput represents the response from the list computer applications API
+type ListComputerApplicationsOutput struct {
+	Results ComputerApplicationResults `json:"results"`
+}
+
+// ComputerApplicationResults contains the actual application data and pagination info
+type ComputerApplicationResults struct {
+	PageInfo PageInfo               `json:"pageInfo"`
+	Data     []*ComputerApplication `json:"data"`
+}
+
+// ComputerApplication represents an application installed on a computer
+type ComputerApplication struct {
+	Name      string `json:"name"`
+	Version   string `json:"version"`
+	Publisher string `json:"publisher"`
 }
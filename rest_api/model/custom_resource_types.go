@@ -0,0 +1,42 @@
+package model
+
+import (
+	"time"
+)
+
+// CustomResource represents a resource of a system Vanta has no integration for, pushed through a custom integration
+type CustomResource struct {
+	ResourceID       string                 `json:"resourceId"` // Stable identifier of the resource in the source system
+	DisplayName      string                 `json:"displayName"`
+	Description      string                 `json:"description,omitempty"`
+	OwnerEmail       string                 `json:"ownerEmail,omitempty"`
+	ExternalURL      string                 `json:"externalUrl,omitempty"`
+	CreationDate     *time.Time             `json:"creationDate,omitempty"`
+	CustomProperties map[string]interface{} `json:"customProperties,omitempty"`
+}
+
+// CustomTestOutcome is the outcome of a custom test for a single resource
+type CustomTestOutcome string
+
+const (
+	CustomTestOutcomePass          CustomTestOutcome = "PASS"
+	CustomTestOutcomeFail          CustomTestOutcome = "FAIL"
+	CustomTestOutcomeNotApplicable CustomTestOutcome = "NOT_APPLICABLE"
+)
+
+// CustomTestResult represents the outcome of a custom test for a resource pushed through a custom integration
+type CustomTestResult struct {
+	ResourceID  string            `json:"resourceId"`
+	Outcome     CustomTestOutcome `json:"outcome"`
+	Reason      string            `json:"reason,omitempty"` // Shown on failing entities
+	CheckedDate *time.Time        `json:"checkedDate,omitempty"`
+}
+
+// CustomSubmissionOutput contains the outcome of a submission to a custom integration, one result per item
+type CustomSubmissionOutput = BatchOutput[*CustomSubmissionResult]
+
+// CustomSubmissionResult represents the outcome of submitting a single resource or test result
+type CustomSubmissionResult struct {
+	ResourceID string `json:"resourceId"`
+	BatchItemStatus
+}
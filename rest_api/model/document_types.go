@@ -0,0 +1,34 @@
+package model
+
+import (
+	"time"
+)
+
+// ListDocumentUploadsOptions represents options for listing the files uploaded to a document
+type ListDocumentUploadsOptions struct {
+	Limit  int    `json:"limit,omitempty"`
+	Cursor string `json:"cursor,omitempty"`
+}
+
+// ListDocumentUploadsOutput represents the response from the list document uploads API
+type ListDocumentUploadsOutput struct {
+	Results UploadedFileResults `json:"results"`
+}
+
+// UploadedFileResults contains the actual uploaded file data and pagination info
+type UploadedFileResults struct {
+	PageInfo PageInfo        `json:"pageInfo"`
+	Data     []*UploadedFile `json:"data"`
+}
+
+// UploadedFile represents a file uploaded as evidence for a document
+type UploadedFile struct {
+	ID              string     `json:"id"`
+	FileName        string     `json:"fileName"`
+	MimeType        string     `json:"mimeType"`
+	Description     *string    `json:"description"`
+	EffectiveAtDate *time.Time `json:"effectiveAtDate"`
+	CreationDate    *time.Time `json:"creationDate"`
+	UpdatedDate     *time.Time `json:"updatedDate"`
+	DeletionDate    *time.Time `json:"deletionDate"`
+}
@@ -6,8 +6,12 @@ import (
 
 // ListPeopleOptions represents options for listing people
 type ListPeopleOptions struct {
-	Limit  int    `json:"limit,omitempty"`
-	Cursor string `json:"cursor,omitempty"`
+	Limit            int    `json:"limit,omitempty"`
+	Cursor           string `json:"cursor,omitempty"`
+	EmploymentStatus string `json:"employmentStatus,omitempty"` // UPCOMING, CURRENT, ON_LEAVE, INACTIVE
+	TaskStatus       string `json:"taskStatus,omitempty"`       // COMPLETE, DUE_SOON, NONE, OVERDUE, PAUSED, OFFBOARDING_*
+	GroupID          string `json:"groupId,omitempty"`          // Filter by group membership
+	EmailAddress     string `json:"emailAddress,omitempty"`     // Filter by email address
 }
 
 // ListPeopleOutput represents the response from the list people API
@@ -85,22 +89,28 @@ type TasksDetails struct {
 
 // TaskDetail represents a generic task detail
 type TaskDetail struct {
-	TaskType       string      `json:"taskType,omitempty"`
-	Status         string      `json:"status,omitempty"`
-	DueDate        *time.Time  `json:"dueDate,omitempty"`
-	CompletionDate *time.Time  `json:"completionDate,omitempty"`
-	Disabled       interface{} `json:"disabled,omitempty"`
+	TaskType       string        `json:"taskType,omitempty"`
+	Status         string        `json:"status,omitempty"`
+	DueDate        *time.Time    `json:"dueDate,omitempty"`
+	CompletionDate *time.Time    `json:"completionDate,omitempty"`
+	Disabled       *TaskDisabled `json:"disabled,omitempty"`
 }
 
 // PolicyTask represents policy acceptance task details
 type PolicyTask struct {
-	TaskType           string      `json:"taskType,omitempty"`
-	Status             string      `json:"status,omitempty"`
-	DueDate            *time.Time  `json:"dueDate,omitempty"`
-	CompletionDate     *time.Time  `json:"completionDate,omitempty"`
-	Disabled           interface{} `json:"disabled,omitempty"`
-	UnacceptedPolicies []Policy    `json:"unacceptedPolicies,omitempty"`
-	AcceptedPolicies   []Policy    `json:"acceptedPolicies,omitempty"`
+	TaskType           string        `json:"taskType,omitempty"`
+	Status             string        `json:"status,omitempty"`
+	DueDate            *time.Time    `json:"dueDate,omitempty"`
+	CompletionDate     *time.Time    `json:"completionDate,omitempty"`
+	Disabled           *TaskDisabled `json:"disabled,omitempty"`
+	UnacceptedPolicies []Policy      `json:"unacceptedPolicies,omitempty"`
+	AcceptedPolicies   []Policy      `json:"acceptedPolicies,omitempty"`
+}
+
+// TaskDisabled contains information about why a task was disabled for a person
+type TaskDisabled struct {
+	Date   *time.Time `json:"date,omitempty"`
+	Reason string     `json:"reason,omitempty"`
 }
 
 // Policy represents a policy item
@@ -110,6 +120,8 @@ type Policy struct {
 
 type TaskStatus string
 
+type TaskType string
+
 type EmploymentStatus string
 
 const (
@@ -122,12 +134,45 @@ const (
 	TaskStatusOffboardingDueSoon            TaskStatus = "OFFBOARDING_DUE_SOON"
 	TaskStatusOffboardingOffboardingOverdue TaskStatus = "OFFBOARDING_OVERDUE"
 
+	TaskTypeCompleteTrainings              TaskType = "COMPLETE_TRAININGS"
+	TaskTypeCompleteCustomTasks            TaskType = "COMPLETE_CUSTOM_TASKS"
+	TaskTypeCompleteOffboardingCustomTasks TaskType = "COMPLETE_OFFBOARDING_CUSTOM_TASKS"
+	TaskTypeCompleteBackgroundChecks       TaskType = "COMPLETE_BACKGROUND_CHECKS"
+	TaskTypeAcceptPolicies                 TaskType = "ACCEPT_POLICIES"
+	TaskTypeInstallDeviceMonitoring        TaskType = "INSTALL_DEVICE_MONITORING"
+
 	EmploymentStatusUpcoming EmploymentStatus = "UPCOMING"
 	EmploymentStatusCurrent  EmploymentStatus = "CURRENT"
 	EmploymentStatusOnLeave  EmploymentStatus = "ON_LEAVE"
 	EmploymentStatusInactive EmploymentStatus = "INACTIVE"
 )
 
+// UpdatePersonEmploymentInput represents the request body for updating the employment of a person.
+// Only the fields set on Employment are changed.
+type UpdatePersonEmploymentInput struct {
+	Employment *Employment `json:"employment"`
+}
+
+// CreateCustomTaskInput represents a custom task to assign to a person
+type CreateCustomTaskInput struct {
+	Name        string     `json:"name"`
+	Description string     `json:"description,omitempty"`
+	DueDate     *time.Time `json:"dueDate,omitempty"`
+	TaskType    TaskType   `json:"taskType"` // COMPLETE_CUSTOM_TASKS or COMPLETE_OFFBOARDING_CUSTOM_TASKS, defaults to COMPLETE_CUSTOM_TASKS
+}
+
+// CustomTask represents a custom onboarding or offboarding task assigned to a person
+type CustomTask struct {
+	ID             string     `json:"id"`
+	PersonID       string     `json:"personId"`
+	Name           string     `json:"name"`
+	Description    string     `json:"description,omitempty"`
+	TaskType       TaskType   `json:"taskType"`
+	Status         TaskStatus `json:"status,omitempty"`
+	DueDate        *time.Time `json:"dueDate,omitempty"`
+	CompletionDate *time.Time `json:"completionDate,omitempty"`
+}
+
 // Policy related types
 
 // ListPoliciesOptions represents options for listing policies
@@ -162,6 +207,36 @@ type PolicyLatestVersion struct {
 	Status string `json:"status"`
 }
 
+// ListPolicyVersionsOptions represents options for listing the versions of a policy
+type ListPolicyVersionsOptions struct {
+	Limit  int    `json:"limit,omitempty"`
+	Cursor string `json:"cursor,omitempty"`
+}
+
+// ListPolicyVersionsOutput represents the response from the list policy versions API
+type ListPolicyVersionsOutput struct {
+	Results PolicyVersionResults `json:"results"`
+}
+
+// PolicyVersionResults contains the actual policy version data and pagination info
+type PolicyVersionResults struct {
+	PageInfo PageInfo         `json:"pageInfo"`
+	Data     []*PolicyVersion `json:"data"`
+}
+
+// PolicyVersion represents a version of a policy document
+type PolicyVersion struct {
+	ID             string     `json:"id"`
+	PolicyID       string     `json:"policyId"`
+	VersionNumber  int        `json:"versionNumber"`
+	Status         string     `json:"status"`
+	ApproverUserID string     `json:"approverUserId"`
+	ApprovedAtDate *time.Time `json:"approvedAtDate,omitempty"`
+	EffectiveDate  *time.Time `json:"effectiveDate,omitempty"`
+	DocumentURL    string     `json:"documentUrl"`
+	CreationDate   *time.Time `json:"creationDate,omitempty"`
+}
+
 type PolicyStatus string
 
 const (
@@ -169,4 +244,3 @@ const (
 	PolicyStatusCompliant        PolicyStatus = "COMPLIANT"
 	PolicyStatusNotStarted       PolicyStatus = "NOT_STARTED"
 )
-
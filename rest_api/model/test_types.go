@@ -71,3 +71,14 @@ type ListTestsOptions struct {
 	CategoryFilter    string `json:"categoryFilter,omitempty"`    // Filter by category
 	IsInRollout       *bool  `json:"isInRollout,omitempty"`       // Filter by rollout status
 }
+
+// SetTestOwnerInput represents the request body for assigning the owner of a test
+type SetTestOwnerInput struct {
+	OwnerID Nullable[string] `json:"ownerId,omitzero"` // ID of the person to assign; Null[string]() to unassign
+}
+
+// DeactivateTestInput represents the request body for deactivating a test
+type DeactivateTestInput struct {
+	DeactivatedReason string     `json:"deactivatedReason"`
+	DeactivatedUntil  *time.Time `json:"deactivatedUntil,omitempty"` // Deactivated indefinitely if not set
+}
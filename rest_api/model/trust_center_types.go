@@ -0,0 +1,126 @@
+package model
+
+import (
+	"time"
+)
+
+// TrustCenter represents the public trust portal of an organization
+type TrustCenter struct {
+	ID                 string     `json:"id"`
+	SlugID             string     `json:"slugId"`
+	CompanyName        string     `json:"companyName"`
+	CompanyDescription *string    `json:"companyDescription"`
+	Title              string     `json:"title"`
+	IsPublic           bool       `json:"isPublic"`
+	CustomDomain       *string    `json:"customDomain"`
+	PrivacyPolicyURL   *string    `json:"privacyPolicyUrl"`
+	CreationDate       *time.Time `json:"creationDate"`
+	UpdatedDate        *time.Time `json:"updatedDate"`
+}
+
+// ListTrustCenterDocumentsOptions represents options for listing the documents of a trust center
+type ListTrustCenterDocumentsOptions struct {
+	Limit  int    `json:"limit,omitempty"`
+	Cursor string `json:"cursor,omitempty"`
+}
+
+// ListTrustCenterDocumentsOutput represents the response from the list trust center documents API
+type ListTrustCenterDocumentsOutput struct {
+	Results TrustCenterDocumentResults `json:"results"`
+}
+
+// TrustCenterDocumentResults contains the actual document data and pagination info
+type TrustCenterDocumentResults struct {
+	PageInfo PageInfo               `json:"pageInfo"`
+	Data     []*TrustCenterDocument `json:"data"`
+}
+
+// TrustCenterDocument represents a document published on a trust center
+type TrustCenterDocument struct {
+	ID           string     `json:"id"`
+	Title        string     `json:"title"`
+	Description  *string    `json:"description"`
+	Category     string     `json:"category"`
+	AccessLevel  string     `json:"accessLevel"` // PUBLIC, REQUIRES_NDA, PRIVATE
+	IsPublished  bool       `json:"isPublished"`
+	FileName     *string    `json:"fileName"`
+	CreationDate *time.Time `json:"creationDate"`
+	UpdatedDate  *time.Time `json:"updatedDate"`
+}
+
+// ListTrustCenterSubprocessorsOptions represents options for listing the subprocessors of a trust center
+type ListTrustCenterSubprocessorsOptions struct {
+	Limit  int    `json:"limit,omitempty"`
+	Cursor string `json:"cursor,omitempty"`
+}
+
+// ListTrustCenterSubprocessorsOutput represents the response from the list trust center subprocessors API
+type ListTrustCenterSubprocessorsOutput struct {
+	Results TrustCenterSubprocessorResults `json:"results"`
+}
+
+// TrustCenterSubprocessorResults contains the actual subprocessor data and pagination info
+type TrustCenterSubprocessorResults struct {
+	PageInfo PageInfo                   `json:"pageInfo"`
+	Data     []*TrustCenterSubprocessor `json:"data"`
+}
+
+// TrustCenterSubprocessor represents a subprocessor disclosed on a trust center
+type TrustCenterSubprocessor struct {
+	ID           string     `json:"id"`
+	Name         string     `json:"name"`
+	Purpose      *string    `json:"purpose"`
+	Location     *string    `json:"location"`
+	WebsiteURL   *string    `json:"websiteUrl"`
+	CreationDate *time.Time `json:"creationDate"`
+	UpdatedDate  *time.Time `json:"updatedDate"`
+}
+
+// ListTrustCenterAccessRequestsOptions represents options for listing the access requests of a trust center
+type ListTrustCenterAccessRequestsOptions struct {
+	Limit  int    `json:"limit,omitempty"`
+	Cursor string `json:"cursor,omitempty"`
+	Status string `json:"status,omitempty"` // PENDING, APPROVED, DENIED, REVOKED
+}
+
+// ListTrustCenterAccessRequestsOutput represents the response from the list trust center access requests API
+type ListTrustCenterAccessRequestsOutput struct {
+	Results TrustCenterAccessRequestResults `json:"results"`
+}
+
+// TrustCenterAccessRequestResults contains the actual access request data and pagination info
+type TrustCenterAccessRequestResults struct {
+	PageInfo PageInfo                    `json:"pageInfo"`
+	Data     []*TrustCenterAccessRequest `json:"data"`
+}
+
+// TrustCenterAccessRequest represents a request from a visitor to access restricted trust center content
+type TrustCenterAccessRequest struct {
+	ID             string     `json:"id"`
+	Name           string     `json:"name"`
+	Email          string     `json:"email"`
+	CompanyName    *string    `json:"companyName"`
+	Reason         *string    `json:"reason"`
+	Status         string     `json:"status"`
+	DocumentIDs    []string   `json:"documentIds"`
+	ReviewerUserID *string    `json:"reviewerUserId"`
+	CreationDate   *time.Time `json:"creationDate"`
+	ReviewedDate   *time.Time `json:"reviewedDate"`
+	ExpirationDate *time.Time `json:"expirationDate"`
+}
+
+// ApproveTrustCenterAccessRequestInput represents the request body for approving a trust center access request
+type ApproveTrustCenterAccessRequestInput struct {
+	DocumentIDs    []string   `json:"documentIds,omitempty"`    // Limit access to these documents; all requested documents if empty
+	ExpirationDate *time.Time `json:"expirationDate,omitempty"` // When the granted access expires
+}
+
+// DenyTrustCenterAccessRequestInput represents the request body for denying a trust center access request
+type DenyTrustCenterAccessRequestInput struct {
+	Reason string `json:"reason,omitempty"`
+}
+
+// RevokeTrustCenterAccessRequestInput represents the request body for revoking a previously approved trust center access request
+type RevokeTrustCenterAccessRequestInput struct {
+	Reason string `json:"reason,omitempty"`
+}
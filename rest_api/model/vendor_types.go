@@ -6,8 +6,14 @@ import (
 
 // ListVendorsOptions represents options for listing vendors
 type ListVendorsOptions struct {
-	Limit  int    `json:"limit,omitempty"`
-	Cursor string `json:"cursor,omitempty"`
+	Limit                       int        `json:"limit,omitempty"`
+	Cursor                      string     `json:"cursor,omitempty"`
+	Name                        string     `json:"name,omitempty"`              // Search vendors by name
+	Status                      string     `json:"status,omitempty"`            // MANAGED, ARCHIVED, IN_PROCUREMENT
+	InherentRiskLevel           string     `json:"inherentRiskLevel,omitempty"` // CRITICAL, HIGH, MEDIUM, LOW
+	ResidualRiskLevel           string     `json:"residualRiskLevel,omitempty"` // CRITICAL, HIGH, MEDIUM, LOW
+	SecurityReviewDueBeforeDate *time.Time `json:"securityReviewDueBeforeDate,omitempty"`
+	SecurityReviewDueAfterDate  *time.Time `json:"securityReviewDueAfterDate,omitempty"`
 }
 
 // ListVendorsOutput represents the response from the list vendors API
@@ -47,7 +53,7 @@ type Vendor struct {
 	ResidualRiskLevel                string             `json:"residualRiskLevel"`
 	VendorHeadquarters               *string            `json:"vendorHeadquarters"`
 	ContractAmount                   *float64           `json:"contractAmount"`
-	CustomFields                     interface{}        `json:"customFields"`
+	CustomFields                     []*CustomField     `json:"customFields"`
 
 	// Deprecated fields - not available in REST API but kept for backward compatibility
 	Title             string      `json:"title,omitempty"`
@@ -74,3 +80,170 @@ type VendorAuthDetails struct {
 	PasswordRequiresSymbol *bool  `json:"passwordRequiresSymbol"`
 	PasswordMinimumLength  *int   `json:"passwordMinimumLength"`
 }
+
+// ListVendorRiskAttributesOptions represents options for listing vendor risk attributes
+type ListVendorRiskAttributesOptions struct {
+	Limit  int    `json:"limit,omitempty"`
+	Cursor string `json:"cursor,omitempty"`
+}
+
+// ListVendorRiskAttributesOutput represents the response from the list vendor risk attributes API
+type ListVendorRiskAttributesOutput struct {
+	Results VendorRiskAttributeResults `json:"results"`
+}
+
+// VendorRiskAttributeResults contains the actual vendor risk attribute data and pagination info
+type VendorRiskAttributeResults struct {
+	PageInfo PageInfo               `json:"pageInfo"`
+	Data     []*VendorRiskAttribute `json:"data"`
+}
+
+// VendorRiskAttribute represents a risk attribute that can be assigned to vendors
+type VendorRiskAttribute struct {
+	ID              string `json:"id"`
+	Name            string `json:"name"`
+	Description     string `json:"description"`
+	RiskLevelImpact string `json:"riskLevelImpact"`
+	Enabled         bool   `json:"enabled"`
+}
+
+// ListVendorSecurityReviewsOptions represents options for listing the security reviews of a vendor
+type ListVendorSecurityReviewsOptions struct {
+	Limit  int    `json:"limit,omitempty"`
+	Cursor string `json:"cursor,omitempty"`
+}
+
+// ListVendorSecurityReviewsOutput represents the response from the list vendor security reviews API
+type ListVendorSecurityReviewsOutput struct {
+	Results VendorSecurityReviewResults `json:"results"`
+}
+
+// VendorSecurityReviewResults contains the actual security review data and pagination info
+type VendorSecurityReviewResults struct {
+	PageInfo PageInfo                `json:"pageInfo"`
+	Data     []*VendorSecurityReview `json:"data"`
+}
+
+// VendorSecurityReview represents a security review performed on a vendor
+type VendorSecurityReview struct {
+	ID                string     `json:"id"`
+	VendorID          string     `json:"vendorId"`
+	Status            string     `json:"status"`
+	StartDate         *time.Time `json:"startDate"`
+	DueDate           *time.Time `json:"dueDate"`
+	CompletionDate    *time.Time `json:"completionDate"`
+	ReviewerUserID    string     `json:"reviewerUserId"`
+	ResidualRiskLevel string     `json:"residualRiskLevel"`
+	Summary           *string    `json:"summary"`
+}
+
+// ListVendorSecurityReviewDocumentsOptions represents options for listing the documents of a vendor security review
+type ListVendorSecurityReviewDocumentsOptions struct {
+	Limit  int    `json:"limit,omitempty"`
+	Cursor string `json:"cursor,omitempty"`
+}
+
+// ListVendorSecurityReviewDocumentsOutput represents the response from the list vendor security review documents API
+type ListVendorSecurityReviewDocumentsOutput struct {
+	Results VendorSecurityReviewDocumentResults `json:"results"`
+}
+
+// VendorSecurityReviewDocumentResults contains the actual document data and pagination info
+type VendorSecurityReviewDocumentResults struct {
+	PageInfo PageInfo                        `json:"pageInfo"`
+	Data     []*VendorSecurityReviewDocument `json:"data"`
+}
+
+// VendorSecurityReviewDocument represents a document attached to a vendor security review
+type VendorSecurityReviewDocument struct {
+	ID           string     `json:"id"`
+	FileName     string     `json:"fileName"`
+	Type         string     `json:"type"`
+	CreationDate *time.Time `json:"creationDate"`
+	URL          string     `json:"url"`
+}
+
+// ListVendorFindingsOptions represents options for listing the findings of a vendor
+type ListVendorFindingsOptions struct {
+	Limit  int    `json:"limit,omitempty"`
+	Cursor string `json:"cursor,omitempty"`
+}
+
+// ListVendorFindingsOutput represents the response from the list vendor findings API
+type ListVendorFindingsOutput struct {
+	Results VendorFindingResults `json:"results"`
+}
+
+// VendorFindingResults contains the actual finding data and pagination info
+type VendorFindingResults struct {
+	PageInfo PageInfo         `json:"pageInfo"`
+	Data     []*VendorFinding `json:"data"`
+}
+
+// VendorFinding represents a finding raised during a vendor security review
+type VendorFinding struct {
+	ID               string     `json:"id"`
+	VendorID         string     `json:"vendorId"`
+	SecurityReviewID string     `json:"securityReviewId"`
+	Content          string     `json:"content"`
+	RiskStatus       string     `json:"riskStatus"`
+	Remediation      *string    `json:"remediation"`
+	Status           string     `json:"status"`
+	CreationDate     *time.Time `json:"creationDate"`
+	DueDate          *time.Time `json:"dueDate"`
+	ResolvedDate     *time.Time `json:"resolvedDate"`
+}
+
+// ListDiscoveredVendorsOptions represents options for listing discovered vendors
+type ListDiscoveredVendorsOptions struct {
+	Limit  int    `json:"limit,omitempty"`
+	Cursor string `json:"cursor,omitempty"`
+}
+
+// ListDiscoveredVendorsOutput represents the response from the list discovered vendors API
+type ListDiscoveredVendorsOutput struct {
+	Results DiscoveredVendorResults `json:"results"`
+}
+
+// DiscoveredVendorResults contains the actual discovered vendor data and pagination info
+type DiscoveredVendorResults struct {
+	PageInfo PageInfo            `json:"pageInfo"`
+	Data     []*DiscoveredVendor `json:"data"`
+}
+
+// DiscoveredVendor represents a vendor discovered by Vanta from SSO or expense integrations
+type DiscoveredVendor struct {
+	ID               string          `json:"id"`
+	Name             string          `json:"name"`
+	Category         *VendorCategory `json:"category"`
+	Source           string          `json:"source"`
+	IntegrationID    string          `json:"integrationId"`
+	DiscoveredDate   *time.Time      `json:"discoveredDate"`
+	NumberOfAccounts *int            `json:"numberOfAccounts"`
+	VendorID         *string         `json:"vendorId"`
+}
+
+// VendorInput represents the request body for creating or updating a vendor.
+// Only the fields that are set are sent; nullable fields can be cleared with Null.
+type VendorInput struct {
+	Name                      *string             `json:"name,omitempty"`
+	WebsiteURL                *string             `json:"websiteUrl,omitempty"`
+	AccountManagerName        Nullable[string]    `json:"accountManagerName,omitzero"`
+	AccountManagerEmail       Nullable[string]    `json:"accountManagerEmail,omitzero"`
+	ServicesProvided          Nullable[string]    `json:"servicesProvided,omitzero"`
+	AdditionalNotes           Nullable[string]    `json:"additionalNotes,omitzero"`
+	SecurityOwnerUserID       *string             `json:"securityOwnerUserId,omitempty"`
+	BusinessOwnerUserID       *string             `json:"businessOwnerUserId,omitempty"`
+	ContractStartDate         Nullable[time.Time] `json:"contractStartDate,omitzero"`
+	ContractRenewalDate       Nullable[time.Time] `json:"contractRenewalDate,omitzero"`
+	ContractTerminationDate   Nullable[time.Time] `json:"contractTerminationDate,omitzero"`
+	NextSecurityReviewDueDate Nullable[time.Time] `json:"nextSecurityReviewDueDate,omitzero"`
+	IsVisibleToAuditors       *bool               `json:"isVisibleToAuditors,omitempty"`
+	IsRiskAutoScored          *bool               `json:"isRiskAutoScored,omitempty"`
+	Category                  *VendorCategory     `json:"category,omitempty"`
+	InherentRiskLevel         *string             `json:"inherentRiskLevel,omitempty"` // CRITICAL, HIGH, MEDIUM, LOW
+	ResidualRiskLevel         *string             `json:"residualRiskLevel,omitempty"` // CRITICAL, HIGH, MEDIUM, LOW
+	VendorHeadquarters        Nullable[string]    `json:"vendorHeadquarters,omitzero"`
+	ContractAmount            Nullable[float64]   `json:"contractAmount,omitzero"`
+	CustomFields              []*CustomField      `json:"customFields,omitempty"`
+}
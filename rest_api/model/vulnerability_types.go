@@ -62,3 +62,25 @@ type Vulnerability struct {
 	RelatedVulns        []string            `json:"relatedVulns,omitempty"`
 	RelatedURLs         []string            `json:"relatedUrls,omitempty"`
 }
+
+// DeactivateVulnerabilityInput represents a vulnerability to deactivate, i.e. accept the risk of
+type DeactivateVulnerabilityInput struct {
+	ID                   string     `json:"id"`
+	DeactivationReason   string     `json:"deactivationReason"`
+	DeactivatedUntilDate *time.Time `json:"deactivatedUntilDate,omitempty"` // Deactivated indefinitely if not set
+}
+
+// OverrideVulnerabilitySLAInput represents a new SLA deadline for a vulnerability
+type OverrideVulnerabilitySLAInput struct {
+	ID              string     `json:"id"`
+	RemediateByDate *time.Time `json:"remediateByDate"` // Removes the SLA if not set
+}
+
+// UpdateVulnerabilitiesOutput contains the outcome of a batch vulnerability update, one result per vulnerability
+type UpdateVulnerabilitiesOutput = BatchOutput[*VulnerabilityUpdateResult]
+
+// VulnerabilityUpdateResult represents the outcome of updating a single vulnerability
+type VulnerabilityUpdateResult struct {
+	ID string `json:"id"`
+	BatchItemStatus
+}
@@ -24,7 +24,7 @@ func (c *RestClient) ListMonitors(ctx context.Context, options *model.ListMonito
 		}
 	}
 
-	resp, err := c.makeRequest(ctx, "GET", "/v1/tests", params)
+	resp, err := c.makeRequest(ctx, "GET", "/v1/tests", params, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make request: %w", err)
 	}
@@ -48,7 +48,7 @@ func (c *RestClient) GetMonitorByID(ctx context.Context, id string) (*model.Moni
 		return nil, fmt.Errorf("monitor ID cannot be empty")
 	}
 
-	resp, err := c.makeRequest(ctx, "GET", fmt.Sprintf("/v1/tests/%s", id), nil)
+	resp, err := c.makeRequest(ctx, "GET", fmt.Sprintf("/v1/tests/%s", id), nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make request: %w", err)
 	}
@@ -87,7 +87,7 @@ func (c *RestClient) ListTestEntities(ctx context.Context, testID string, option
 		}
 	}
 
-	resp, err := c.makeRequest(ctx, "GET", fmt.Sprintf("/v1/tests/%s/entities", testID), params)
+	resp, err := c.makeRequest(ctx, "GET", fmt.Sprintf("/v1/tests/%s/entities", testID), params, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make request: %w", err)
 	}
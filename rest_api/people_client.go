@@ -22,9 +22,21 @@ func (c *RestClient) ListPeople(ctx context.Context, options *model.ListPeopleOp
 		if options.Cursor != "" {
 			params.Set("pageCursor", options.Cursor)
 		}
+		if options.EmploymentStatus != "" {
+			params.Set("employmentStatus", options.EmploymentStatus)
+		}
+		if options.TaskStatus != "" {
+			params.Set("taskStatus", options.TaskStatus)
+		}
+		if options.GroupID != "" {
+			params.Set("groupId", options.GroupID)
+		}
+		if options.EmailAddress != "" {
+			params.Set("emailAddress", options.EmailAddress)
+		}
 	}
 
-	resp, err := c.makeRequest(ctx, "GET", "/v1/people", params)
+	resp, err := c.makeRequest(ctx, "GET", "/v1/people", params, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make request: %w", err)
 	}
@@ -54,7 +66,7 @@ func (c *RestClient) GetPersonByID(ctx context.Context, id string) (*model.Perso
 		return nil, fmt.Errorf("person ID cannot be empty")
 	}
 
-	resp, err := c.makeRequest(ctx, "GET", fmt.Sprintf("/v1/people/%s", id), nil)
+	resp, err := c.makeRequest(ctx, "GET", fmt.Sprintf("/v1/people/%s", id), nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make request: %w", err)
 	}
@@ -71,3 +83,91 @@ func (c *RestClient) GetPersonByID(ctx context.Context, id string) (*model.Perso
 
 	return person, nil
 }
+
+// UpdatePersonEmployment updates the employment status, dates or job title of a person and returns the updated person.
+// Setting the status to ON_LEAVE or INACTIVE puts the person on leave or starts their offboarding.
+func (c *RestClient) UpdatePersonEmployment(ctx context.Context, id string, employment *model.Employment) (*model.Person, error) {
+	if id == "" {
+		return nil, fmt.Errorf("person ID cannot be empty")
+	}
+	if employment == nil {
+		return nil, fmt.Errorf("employment cannot be nil")
+	}
+
+	var person *model.Person
+	if err := c.patch(ctx, fmt.Sprintf("/v1/people/%s", id), &model.UpdatePersonEmploymentInput{Employment: employment}, &person); err != nil {
+		return nil, err
+	}
+
+	return person, nil
+}
+
+// MarkPersonOffboardingComplete marks the offboarding of a person as complete and returns the updated person
+func (c *RestClient) MarkPersonOffboardingComplete(ctx context.Context, id string) (*model.Person, error) {
+	if id == "" {
+		return nil, fmt.Errorf("person ID cannot be empty")
+	}
+
+	var person *model.Person
+	if err := c.post(ctx, fmt.Sprintf("/v1/people/%s/mark-offboarding-complete", id), nil, &person); err != nil {
+		return nil, err
+	}
+
+	return person, nil
+}
+
+// AssignPersonCustomTask assigns a custom onboarding or offboarding task to a person and returns the created task,
+// whose ID is used to complete it with CompletePersonCustomTask
+func (c *RestClient) AssignPersonCustomTask(ctx context.Context, id string, input *model.CreateCustomTaskInput) (*model.CustomTask, error) {
+	if id == "" {
+		return nil, fmt.Errorf("person ID cannot be empty")
+	}
+	if input == nil || input.Name == "" {
+		return nil, fmt.Errorf("custom task name cannot be empty")
+	}
+
+	task := *input
+	switch task.TaskType {
+	case "":
+		task.TaskType = model.TaskTypeCompleteCustomTasks
+	case model.TaskTypeCompleteCustomTasks, model.TaskTypeCompleteOffboardingCustomTasks:
+	default:
+		return nil, fmt.Errorf("unsupported custom task type: %s", task.TaskType)
+	}
+
+	var customTask *model.CustomTask
+	if err := c.post(ctx, fmt.Sprintf("/v1/people/%s/custom-tasks", id), &task, &customTask); err != nil {
+		return nil, err
+	}
+
+	return customTask, nil
+}
+
+// CompletePersonCustomTask marks a custom task of a person, as returned by AssignPersonCustomTask, as complete and returns the updated person
+func (c *RestClient) CompletePersonCustomTask(ctx context.Context, id, taskID string) (*model.Person, error) {
+	if id == "" {
+		return nil, fmt.Errorf("person ID cannot be empty")
+	}
+	if taskID == "" {
+		return nil, fmt.Errorf("custom task ID cannot be empty")
+	}
+
+	var person *model.Person
+	if err := c.post(ctx, fmt.Sprintf("/v1/people/%s/custom-tasks/%s/complete", id, taskID), nil, &person); err != nil {
+		return nil, err
+	}
+
+	return person, nil
+}
+
+// AddPersonToGroup adds a person to a group
+func (c *RestClient) AddPersonToGroup(ctx context.Context, id, groupID string) error {
+	if id == "" {
+		return fmt.Errorf("person ID cannot be empty")
+	}
+	if groupID == "" {
+		return fmt.Errorf("group ID cannot be empty")
+	}
+
+	return c.post(ctx, fmt.Sprintf("/v1/groups/%s/people", groupID), map[string]string{"personId": id}, nil)
+}
@@ -23,7 +23,7 @@ func (c *RestClient) ListPolicies(ctx context.Context, options *model.ListPolici
 		}
 	}
 
-	resp, err := c.makeRequest(ctx, "GET", "/v1/policies", params)
+	resp, err := c.makeRequest(ctx, "GET", "/v1/policies", params, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make request: %w", err)
 	}
@@ -47,7 +47,7 @@ func (c *RestClient) GetPolicyByID(ctx context.Context, id string) (*model.Polic
 		return nil, fmt.Errorf("policy ID cannot be empty")
 	}
 
-	resp, err := c.makeRequest(ctx, "GET", fmt.Sprintf("/v1/policies/%s", id), nil)
+	resp, err := c.makeRequest(ctx, "GET", fmt.Sprintf("/v1/policies/%s", id), nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make request: %w", err)
 	}
@@ -64,3 +64,39 @@ func (c *RestClient) GetPolicyByID(ctx context.Context, id string) (*model.Polic
 
 	return policy, nil
 }
+
+// ListPolicyVersions retrieves a paginated list of versions for a specific policy
+func (c *RestClient) ListPolicyVersions(ctx context.Context, policyID string, options *model.ListPolicyVersionsOptions) (*model.ListPolicyVersionsOutput, error) {
+	if policyID == "" {
+		return nil, fmt.Errorf("policy ID cannot be empty")
+	}
+
+	// Build URL with query parameters
+	params := url.Values{}
+
+	if options != nil {
+		if options.Limit > 0 {
+			params.Set("pageSize", fmt.Sprintf("%d", options.Limit))
+		}
+		if options.Cursor != "" {
+			params.Set("pageCursor", options.Cursor)
+		}
+	}
+
+	resp, err := c.makeRequest(ctx, "GET", fmt.Sprintf("/v1/policies/%s/versions", policyID), params, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+
+	respBodyBytes, err := c.readResponseBody(resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result *model.ListPolicyVersionsOutput
+	if err = json.Unmarshal(respBodyBytes, &result); err != nil {
+		return nil, fmt.Errorf("failed to JSON-decode response body: %w", err)
+	}
+
+	return result, nil
+}
@@ -1,14 +1,47 @@
 package rest_api
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"maps"
+	"mime/multipart"
 	"net/http"
 	"net/url"
+	"slices"
+	"strconv"
+
+	"github.com/turbot/steampipe-plugin-vanta/v2/rest_api/model"
 )
 
+// idempotencyKeyContextKey is the context key under which the idempotency key of a write request is stored
+type idempotencyKeyContextKey struct{}
+
+// WithIdempotencyKey returns a context that makes write requests carry the given Idempotency-Key header,
+// so retrying a request that may already have been applied does not apply it twice
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyContextKey{}, key)
+}
+
+// APIError is returned when the API responds with a non-2xx status code
+type APIError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("received non-2xx http response status code (%d), body: %s", e.StatusCode, e.Body)
+}
+
+// IsNotFound reports whether err was caused by a 404 response from the API
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
 // TokenStore interface for managing authentication tokens
 type TokenStore interface {
 	GetToken() (tokenType, token string)
@@ -35,8 +68,62 @@ func (c *RestClient) SetHTTPClient(client *http.Client) {
 	c.httpClient = client
 }
 
-// makeRequest performs HTTP requests with proper authentication
-func (c *RestClient) makeRequest(ctx context.Context, method, path string, queryParams url.Values) (*http.Response, error) {
+// makeRequest performs HTTP requests with proper authentication.
+// If body is not nil, it is JSON-encoded and sent as the request body.
+func (c *RestClient) makeRequest(ctx context.Context, method, path string, queryParams url.Values, body interface{}) (*http.Response, error) {
+	var reqBody io.Reader
+	if body != nil {
+		bodyBytes, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to JSON-encode request body: %v", err)
+		}
+		reqBody = bytes.NewReader(bodyBytes)
+	}
+
+	return c.sendRequest(ctx, method, path, queryParams, reqBody, "application/json")
+}
+
+// makeMultipartRequest performs a multipart/form-data request with the given form fields and file.
+// The file is streamed to the server as it is read, without buffering it in memory.
+func (c *RestClient) makeMultipartRequest(ctx context.Context, method, path string, fields map[string]string, fileField, filename string, file io.Reader) (*http.Response, error) {
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+
+	go func() {
+		pw.CloseWithError(writeMultipartBody(mw, fields, fileField, filename, file))
+	}()
+
+	resp, err := c.sendRequest(ctx, method, path, nil, pr, mw.FormDataContentType())
+	if err != nil {
+		// Unblock the writer if the request body was never consumed
+		pr.CloseWithError(err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// writeMultipartBody writes the form fields followed by the file to mw
+func writeMultipartBody(mw *multipart.Writer, fields map[string]string, fileField, filename string, file io.Reader) error {
+	for _, name := range slices.Sorted(maps.Keys(fields)) {
+		if err := mw.WriteField(name, fields[name]); err != nil {
+			return fmt.Errorf("failed to write form field %s: %v", name, err)
+		}
+	}
+
+	part, err := mw.CreateFormFile(fileField, filename)
+	if err != nil {
+		return fmt.Errorf("failed to create form file: %v", err)
+	}
+	if _, err := io.Copy(part, file); err != nil {
+		return fmt.Errorf("failed to write form file: %v", err)
+	}
+
+	return mw.Close()
+}
+
+// sendRequest builds and executes an authenticated HTTP request with the given body and content type
+func (c *RestClient) sendRequest(ctx context.Context, method, path string, queryParams url.Values, body io.Reader, contentType string) (*http.Response, error) {
 	tokenType, token := c.tokenStore.GetToken()
 	if token == "" {
 		return nil, errors.New("no auth token present")
@@ -51,14 +138,17 @@ func (c *RestClient) makeRequest(ctx context.Context, method, path string, query
 		u.RawQuery = queryParams.Encode()
 	}
 
-	req, err := http.NewRequest(method, u.String(), nil)
+	req, err := http.NewRequest(method, u.String(), body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build http request: %v", err)
 	}
 
 	req = req.WithContext(ctx)
-	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Content-Type", contentType)
 	req.Header.Set("Authorization", fmt.Sprintf("%s %s", tokenType, token))
+	if key, ok := ctx.Value(idempotencyKeyContextKey{}).(string); ok && key != "" && method != "GET" {
+		req.Header.Set("Idempotency-Key", key)
+	}
 
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
@@ -77,9 +167,79 @@ func (c *RestClient) readResponseBody(resp *http.Response) ([]byte, error) {
 		return nil, fmt.Errorf("failed to read http response body: %v", err)
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("received non-200 http response status code (%d), body: %s", resp.StatusCode, string(respBodyBytes))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, &APIError{StatusCode: resp.StatusCode, Body: string(respBodyBytes)}
 	}
 
 	return respBodyBytes, nil
 }
+
+// post sends a POST request with a JSON body and decodes the response into result, if any
+func (c *RestClient) post(ctx context.Context, path string, body, result interface{}) error {
+	return c.doJSON(ctx, "POST", path, body, result)
+}
+
+// patch sends a PATCH request with a JSON body and decodes the response into result, if any
+func (c *RestClient) patch(ctx context.Context, path string, body, result interface{}) error {
+	return c.doJSON(ctx, "PATCH", path, body, result)
+}
+
+// delete sends a DELETE request, ignoring any response body
+func (c *RestClient) delete(ctx context.Context, path string) error {
+	return c.doJSON(ctx, "DELETE", path, nil, nil)
+}
+
+// doJSON performs a write request and decodes the JSON response into result.
+// Empty responses, such as 204 No Content, leave result untouched.
+func (c *RestClient) doJSON(ctx context.Context, method, path string, body, result interface{}) error {
+	resp, err := c.makeRequest(ctx, method, path, nil, body)
+	if err != nil {
+		return fmt.Errorf("failed to make request: %w", err)
+	}
+
+	respBodyBytes, err := c.readResponseBody(resp)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if result == nil || len(bytes.TrimSpace(respBodyBytes)) == 0 {
+		return nil
+	}
+
+	if err = json.Unmarshal(respBodyBytes, result); err != nil {
+		return fmt.Errorf("failed to JSON-decode response body: %w", err)
+	}
+
+	return nil
+}
+
+// maxBatchSize is the maximum number of items sent by a single batch write request
+const maxBatchSize = 100
+
+// sendBatches sends the items in batches under the given body key and collects the per-item results.
+// If the context carries an idempotency key and more than one batch is needed, each batch is sent with
+// the key suffixed by its index, so the server does not treat later batches as replays of the first.
+// If a batch fails, the results of the batches already applied are returned along with the error.
+func sendBatches[R model.BatchItemResult](ctx context.Context, c *RestClient, method, path, key string, items []interface{}) (*model.BatchOutput[R], error) {
+	output := &model.BatchOutput[R]{}
+	idempotencyKey, _ := ctx.Value(idempotencyKeyContextKey{}).(string)
+
+	for batch, start := 0, 0; start < len(items); batch, start = batch+1, start+maxBatchSize {
+		end := min(start+maxBatchSize, len(items))
+
+		batchCtx := ctx
+		if idempotencyKey != "" && len(items) > maxBatchSize {
+			batchCtx = WithIdempotencyKey(ctx, idempotencyKey+"-"+strconv.Itoa(batch))
+		}
+
+		var result *model.BatchOutput[R]
+		if err := c.doJSON(batchCtx, method, path, map[string]interface{}{key: items[start:end]}, &result); err != nil {
+			return output, fmt.Errorf("failed to send items %d to %d: %w", start+1, end, err)
+		}
+		if result != nil {
+			output.Results = append(output.Results, result.Results...)
+		}
+	}
+
+	return output, nil
+}
@@ -45,7 +45,7 @@ func (c *RestClient) ListTests(ctx context.Context, options *model.ListTestsOpti
 		}
 	}
 
-	resp, err := c.makeRequest(ctx, "GET", "/v1/tests", params)
+	resp, err := c.makeRequest(ctx, "GET", "/v1/tests", params, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make request: %w", err)
 	}
@@ -69,7 +69,7 @@ func (c *RestClient) GetTestByID(ctx context.Context, id string) (*model.Test, e
 		return nil, fmt.Errorf("test ID cannot be empty")
 	}
 
-	resp, err := c.makeRequest(ctx, "GET", fmt.Sprintf("/v1/tests/%s", id), nil)
+	resp, err := c.makeRequest(ctx, "GET", fmt.Sprintf("/v1/tests/%s", id), nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make request: %w", err)
 	}
@@ -86,3 +86,54 @@ func (c *RestClient) GetTestByID(ctx context.Context, id string) (*model.Test, e
 
 	return test, nil
 }
+
+// SetTestOwner assigns the owner of a test, or unassigns it if the owner ID is explicitly null, and returns the updated test
+func (c *RestClient) SetTestOwner(ctx context.Context, id string, input *model.SetTestOwnerInput) (*model.Test, error) {
+	if id == "" {
+		return nil, fmt.Errorf("test ID cannot be empty")
+	}
+	if input == nil || !input.OwnerID.Set {
+		return nil, fmt.Errorf("owner ID must be set, or explicitly null to unassign the owner")
+	}
+	if !input.OwnerID.Null && input.OwnerID.Value == "" {
+		return nil, fmt.Errorf("owner ID cannot be empty")
+	}
+
+	var test *model.Test
+	if err := c.post(ctx, fmt.Sprintf("/v1/tests/%s/set-owner", id), input, &test); err != nil {
+		return nil, err
+	}
+
+	return test, nil
+}
+
+// DeactivateTest deactivates a test with a reason, until a date or indefinitely, and returns the updated test
+func (c *RestClient) DeactivateTest(ctx context.Context, id string, input *model.DeactivateTestInput) (*model.Test, error) {
+	if id == "" {
+		return nil, fmt.Errorf("test ID cannot be empty")
+	}
+	if input == nil || input.DeactivatedReason == "" {
+		return nil, fmt.Errorf("deactivation reason cannot be empty")
+	}
+
+	var test *model.Test
+	if err := c.post(ctx, fmt.Sprintf("/v1/tests/%s/deactivate", id), input, &test); err != nil {
+		return nil, err
+	}
+
+	return test, nil
+}
+
+// ReactivateTest reactivates a deactivated test and returns the updated test
+func (c *RestClient) ReactivateTest(ctx context.Context, id string) (*model.Test, error) {
+	if id == "" {
+		return nil, fmt.Errorf("test ID cannot be empty")
+	}
+
+	var test *model.Test
+	if err := c.post(ctx, fmt.Sprintf("/v1/tests/%s/reactivate", id), nil, &test); err != nil {
+		return nil, err
+	}
+
+	return test, nil
+}
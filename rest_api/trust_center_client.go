@@ -0,0 +1,186 @@
+package rest_api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/turbot/steampipe-plugin-vanta/v2/rest_api/model"
+)
+
+// GetTrustCenter retrieves a specific trust center by its slug ID
+func (c *RestClient) GetTrustCenter(ctx context.Context, slugID string) (*model.TrustCenter, error) {
+	if slugID == "" {
+		return nil, fmt.Errorf("trust center slug ID cannot be empty")
+	}
+
+	resp, err := c.makeRequest(ctx, "GET", fmt.Sprintf("/v1/trust-centers/%s", slugID), nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+
+	respBodyBytes, err := c.readResponseBody(resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var trustCenter *model.TrustCenter
+	if err = json.Unmarshal(respBodyBytes, &trustCenter); err != nil {
+		return nil, fmt.Errorf("failed to JSON-decode response body: %w", err)
+	}
+
+	return trustCenter, nil
+}
+
+// ListTrustCenterDocuments retrieves a paginated list of documents published on a trust center
+func (c *RestClient) ListTrustCenterDocuments(ctx context.Context, slugID string, options *model.ListTrustCenterDocumentsOptions) (*model.ListTrustCenterDocumentsOutput, error) {
+	if slugID == "" {
+		return nil, fmt.Errorf("trust center slug ID cannot be empty")
+	}
+
+	// Build URL with query parameters
+	params := url.Values{}
+
+	if options != nil {
+		if options.Limit > 0 {
+			params.Set("pageSize", fmt.Sprintf("%d", options.Limit))
+		}
+		if options.Cursor != "" {
+			params.Set("pageCursor", options.Cursor)
+		}
+	}
+
+	resp, err := c.makeRequest(ctx, "GET", fmt.Sprintf("/v1/trust-centers/%s/documents", slugID), params, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+
+	respBodyBytes, err := c.readResponseBody(resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result *model.ListTrustCenterDocumentsOutput
+	if err = json.Unmarshal(respBodyBytes, &result); err != nil {
+		return nil, fmt.Errorf("failed to JSON-decode response body: %w", err)
+	}
+
+	return result, nil
+}
+
+// ListTrustCenterSubprocessors retrieves a paginated list of subprocessors disclosed on a trust center
+func (c *RestClient) ListTrustCenterSubprocessors(ctx context.Context, slugID string, options *model.ListTrustCenterSubprocessorsOptions) (*model.ListTrustCenterSubprocessorsOutput, error) {
+	if slugID == "" {
+		return nil, fmt.Errorf("trust center slug ID cannot be empty")
+	}
+
+	// Build URL with query parameters
+	params := url.Values{}
+
+	if options != nil {
+		if options.Limit > 0 {
+			params.Set("pageSize", fmt.Sprintf("%d", options.Limit))
+		}
+		if options.Cursor != "" {
+			params.Set("pageCursor", options.Cursor)
+		}
+	}
+
+	resp, err := c.makeRequest(ctx, "GET", fmt.Sprintf("/v1/trust-centers/%s/subprocessors", slugID), params, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+
+	respBodyBytes, err := c.readResponseBody(resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result *model.ListTrustCenterSubprocessorsOutput
+	if err = json.Unmarshal(respBodyBytes, &result); err != nil {
+		return nil, fmt.Errorf("failed to JSON-decode response body: %w", err)
+	}
+
+	return result, nil
+}
+
+// ListTrustCenterAccessRequests retrieves a paginated list of access requests submitted to a trust center
+func (c *RestClient) ListTrustCenterAccessRequests(ctx context.Context, slugID string, options *model.ListTrustCenterAccessRequestsOptions) (*model.ListTrustCenterAccessRequestsOutput, error) {
+	if slugID == "" {
+		return nil, fmt.Errorf("trust center slug ID cannot be empty")
+	}
+
+	// Build URL with query parameters
+	params := url.Values{}
+
+	if options != nil {
+		if options.Limit > 0 {
+			params.Set("pageSize", fmt.Sprintf("%d", options.Limit))
+		}
+		if options.Cursor != "" {
+			params.Set("pageCursor", options.Cursor)
+		}
+		if options.Status != "" {
+			params.Set("status", options.Status)
+		}
+	}
+
+	resp, err := c.makeRequest(ctx, "GET", fmt.Sprintf("/v1/trust-centers/%s/access-requests", slugID), params, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+
+	respBodyBytes, err := c.readResponseBody(resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result *model.ListTrustCenterAccessRequestsOutput
+	if err = json.Unmarshal(respBodyBytes, &result); err != nil {
+		return nil, fmt.Errorf("failed to JSON-decode response body: %w", err)
+	}
+
+	return result, nil
+}
+
+// ApproveTrustCenterAccessRequest grants a visitor access to restricted trust center content
+func (c *RestClient) ApproveTrustCenterAccessRequest(ctx context.Context, slugID, accessRequestID string, input *model.ApproveTrustCenterAccessRequestInput) (*model.TrustCenterAccessRequest, error) {
+	if input == nil {
+		input = &model.ApproveTrustCenterAccessRequestInput{}
+	}
+	return c.reviewTrustCenterAccessRequest(ctx, slugID, accessRequestID, "approve", input)
+}
+
+// DenyTrustCenterAccessRequest rejects a pending trust center access request
+func (c *RestClient) DenyTrustCenterAccessRequest(ctx context.Context, slugID, accessRequestID string, input *model.DenyTrustCenterAccessRequestInput) (*model.TrustCenterAccessRequest, error) {
+	if input == nil {
+		input = &model.DenyTrustCenterAccessRequestInput{}
+	}
+	return c.reviewTrustCenterAccessRequest(ctx, slugID, accessRequestID, "deny", input)
+}
+
+// RevokeTrustCenterAccessRequest withdraws access previously granted through an approved trust center access request
+func (c *RestClient) RevokeTrustCenterAccessRequest(ctx context.Context, slugID, accessRequestID string, input *model.RevokeTrustCenterAccessRequestInput) (*model.TrustCenterAccessRequest, error) {
+	if input == nil {
+		input = &model.RevokeTrustCenterAccessRequestInput{}
+	}
+	return c.reviewTrustCenterAccessRequest(ctx, slugID, accessRequestID, "revoke", input)
+}
+
+// reviewTrustCenterAccessRequest posts a review action for a trust center access request and returns the updated request
+func (c *RestClient) reviewTrustCenterAccessRequest(ctx context.Context, slugID, accessRequestID, action string, body interface{}) (*model.TrustCenterAccessRequest, error) {
+	if slugID == "" {
+		return nil, fmt.Errorf("trust center slug ID cannot be empty")
+	}
+	if accessRequestID == "" {
+		return nil, fmt.Errorf("access request ID cannot be empty")
+	}
+
+	var accessRequest *model.TrustCenterAccessRequest
+	if err := c.post(ctx, fmt.Sprintf("/v1/trust-centers/%s/access-requests/%s/%s", slugID, accessRequestID, action), body, &accessRequest); err != nil {
+		return nil, err
+	}
+
+	return accessRequest, nil
+}
@@ -21,9 +21,27 @@ func (c *RestClient) ListVendors(ctx context.Context, options *model.ListVendors
 		if options.Cursor != "" {
 			params.Set("pageCursor", options.Cursor)
 		}
+		if options.Name != "" {
+			params.Set("name", options.Name)
+		}
+		if options.Status != "" {
+			params.Set("status", options.Status)
+		}
+		if options.InherentRiskLevel != "" {
+			params.Set("inherentRiskLevel", options.InherentRiskLevel)
+		}
+		if options.ResidualRiskLevel != "" {
+			params.Set("residualRiskLevel", options.ResidualRiskLevel)
+		}
+		if options.SecurityReviewDueBeforeDate != nil {
+			params.Set("securityReviewDueBeforeDate", options.SecurityReviewDueBeforeDate.Format("2006-01-02T15:04:05.000Z"))
+		}
+		if options.SecurityReviewDueAfterDate != nil {
+			params.Set("securityReviewDueAfterDate", options.SecurityReviewDueAfterDate.Format("2006-01-02T15:04:05.000Z"))
+		}
 	}
 
-	resp, err := c.makeRequest(ctx, "GET", "/v1/vendors", params)
+	resp, err := c.makeRequest(ctx, "GET", "/v1/vendors", params, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make request: %w", err)
 	}
@@ -47,7 +65,7 @@ func (c *RestClient) GetVendorByID(ctx context.Context, id string) (*model.Vendo
 		return nil, fmt.Errorf("vendor ID cannot be empty")
 	}
 
-	resp, err := c.makeRequest(ctx, "GET", fmt.Sprintf("/v1/vendors/%s", id), nil)
+	resp, err := c.makeRequest(ctx, "GET", fmt.Sprintf("/v1/vendors/%s", id), nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make request: %w", err)
 	}
@@ -64,3 +82,243 @@ func (c *RestClient) GetVendorByID(ctx context.Context, id string) (*model.Vendo
 
 	return vendor, nil
 }
+
+// ListVendorRiskAttributes retrieves a paginated list of vendor risk attributes from Vanta
+func (c *RestClient) ListVendorRiskAttributes(ctx context.Context, options *model.ListVendorRiskAttributesOptions) (*model.ListVendorRiskAttributesOutput, error) {
+	// Build URL with query parameters
+	params := url.Values{}
+
+	if options != nil {
+		if options.Limit > 0 {
+			params.Set("pageSize", fmt.Sprintf("%d", options.Limit))
+		}
+		if options.Cursor != "" {
+			params.Set("pageCursor", options.Cursor)
+		}
+	}
+
+	resp, err := c.makeRequest(ctx, "GET", "/v1/vendor-risk-attributes", params, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+
+	respBodyBytes, err := c.readResponseBody(resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result *model.ListVendorRiskAttributesOutput
+	if err = json.Unmarshal(respBodyBytes, &result); err != nil {
+		return nil, fmt.Errorf("failed to JSON-decode response body: %w", err)
+	}
+
+	return result, nil
+}
+
+// ListVendorSecurityReviews retrieves a paginated list of security reviews for a specific vendor
+func (c *RestClient) ListVendorSecurityReviews(ctx context.Context, vendorID string, options *model.ListVendorSecurityReviewsOptions) (*model.ListVendorSecurityReviewsOutput, error) {
+	if vendorID == "" {
+		return nil, fmt.Errorf("vendor ID cannot be empty")
+	}
+
+	// Build URL with query parameters
+	params := url.Values{}
+
+	if options != nil {
+		if options.Limit > 0 {
+			params.Set("pageSize", fmt.Sprintf("%d", options.Limit))
+		}
+		if options.Cursor != "" {
+			params.Set("pageCursor", options.Cursor)
+		}
+	}
+
+	resp, err := c.makeRequest(ctx, "GET", fmt.Sprintf("/v1/vendors/%s/security-reviews", vendorID), params, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+
+	respBodyBytes, err := c.readResponseBody(resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result *model.ListVendorSecurityReviewsOutput
+	if err = json.Unmarshal(respBodyBytes, &result); err != nil {
+		return nil, fmt.Errorf("failed to JSON-decode response body: %w", err)
+	}
+
+	return result, nil
+}
+
+// ListVendorSecurityReviewDocuments retrieves a paginated list of documents attached to a vendor security review
+func (c *RestClient) ListVendorSecurityReviewDocuments(ctx context.Context, vendorID, securityReviewID string, options *model.ListVendorSecurityReviewDocumentsOptions) (*model.ListVendorSecurityReviewDocumentsOutput, error) {
+	if vendorID == "" {
+		return nil, fmt.Errorf("vendor ID cannot be empty")
+	}
+	if securityReviewID == "" {
+		return nil, fmt.Errorf("security review ID cannot be empty")
+	}
+
+	// Build URL with query parameters
+	params := url.Values{}
+
+	if options != nil {
+		if options.Limit > 0 {
+			params.Set("pageSize", fmt.Sprintf("%d", options.Limit))
+		}
+		if options.Cursor != "" {
+			params.Set("pageCursor", options.Cursor)
+		}
+	}
+
+	resp, err := c.makeRequest(ctx, "GET", fmt.Sprintf("/v1/vendors/%s/security-reviews/%s/documents", vendorID, securityReviewID), params, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+
+	respBodyBytes, err := c.readResponseBody(resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result *model.ListVendorSecurityReviewDocumentsOutput
+	if err = json.Unmarshal(respBodyBytes, &result); err != nil {
+		return nil, fmt.Errorf("failed to JSON-decode response body: %w", err)
+	}
+
+	return result, nil
+}
+
+// ListVendorFindings retrieves a paginated list of findings for a specific vendor
+func (c *RestClient) ListVendorFindings(ctx context.Context, vendorID string, options *model.ListVendorFindingsOptions) (*model.ListVendorFindingsOutput, error) {
+	if vendorID == "" {
+		return nil, fmt.Errorf("vendor ID cannot be empty")
+	}
+
+	// Build URL with query parameters
+	params := url.Values{}
+
+	if options != nil {
+		if options.Limit > 0 {
+			params.Set("pageSize", fmt.Sprintf("%d", options.Limit))
+		}
+		if options.Cursor != "" {
+			params.Set("pageCursor", options.Cursor)
+		}
+	}
+
+	resp, err := c.makeRequest(ctx, "GET", fmt.Sprintf("/v1/vendors/%s/findings", vendorID), params, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+
+	respBodyBytes, err := c.readResponseBody(resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result *model.ListVendorFindingsOutput
+	if err = json.Unmarshal(respBodyBytes, &result); err != nil {
+		return nil, fmt.Errorf("failed to JSON-decode response body: %w", err)
+	}
+
+	return result, nil
+}
+
+// ListDiscoveredVendors retrieves a paginated list of vendors discovered by Vanta
+func (c *RestClient) ListDiscoveredVendors(ctx context.Context, options *model.ListDiscoveredVendorsOptions) (*model.ListDiscoveredVendorsOutput, error) {
+	// Build URL with query parameters
+	params := url.Values{}
+
+	if options != nil {
+		if options.Limit > 0 {
+			params.Set("pageSize", fmt.Sprintf("%d", options.Limit))
+		}
+		if options.Cursor != "" {
+			params.Set("pageCursor", options.Cursor)
+		}
+	}
+
+	resp, err := c.makeRequest(ctx, "GET", "/v1/discovered-vendors", params, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+
+	respBodyBytes, err := c.readResponseBody(resp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	var result *model.ListDiscoveredVendorsOutput
+	if err = json.Unmarshal(respBodyBytes, &result); err != nil {
+		return nil, fmt.Errorf("failed to JSON-decode response body: %w", err)
+	}
+
+	return result, nil
+}
+
+// CreateVendor creates a vendor and returns it
+func (c *RestClient) CreateVendor(ctx context.Context, input *model.VendorInput) (*model.Vendor, error) {
+	if input == nil || input.Name == nil || *input.Name == "" {
+		return nil, fmt.Errorf("vendor name cannot be empty")
+	}
+
+	var vendor *model.Vendor
+	if err := c.post(ctx, "/v1/vendors", input, &vendor); err != nil {
+		return nil, err
+	}
+
+	return vendor, nil
+}
+
+// UpdateVendor updates the fields of a vendor that are set in the input and returns the updated vendor
+func (c *RestClient) UpdateVendor(ctx context.Context, id string, input *model.VendorInput) (*model.Vendor, error) {
+	if id == "" {
+		return nil, fmt.Errorf("vendor ID cannot be empty")
+	}
+	if input == nil {
+		return nil, fmt.Errorf("vendor input cannot be nil")
+	}
+
+	var vendor *model.Vendor
+	if err := c.patch(ctx, fmt.Sprintf("/v1/vendors/%s", id), input, &vendor); err != nil {
+		return nil, err
+	}
+
+	return vendor, nil
+}
+
+// SetVendorStatus moves a vendor to the given status, e.g. MANAGED, ARCHIVED, IN_PROCUREMENT, and returns the updated vendor
+func (c *RestClient) SetVendorStatus(ctx context.Context, id, status string) (*model.Vendor, error) {
+	if id == "" {
+		return nil, fmt.Errorf("vendor ID cannot be empty")
+	}
+	if status == "" {
+		return nil, fmt.Errorf("vendor status cannot be empty")
+	}
+
+	var vendor *model.Vendor
+	if err := c.post(ctx, fmt.Sprintf("/v1/vendors/%s/set-status", id), map[string]string{"status": status}, &vendor); err != nil {
+		return nil, err
+	}
+
+	return vendor, nil
+}
+
+// SetVendorRiskAttributes replaces the risk attributes assigned to a vendor and returns the updated vendor
+func (c *RestClient) SetVendorRiskAttributes(ctx context.Context, id string, riskAttributeIDs []string) (*model.Vendor, error) {
+	if id == "" {
+		return nil, fmt.Errorf("vendor ID cannot be empty")
+	}
+	if riskAttributeIDs == nil {
+		riskAttributeIDs = []string{}
+	}
+
+	var vendor *model.Vendor
+	if err := c.post(ctx, fmt.Sprintf("/v1/vendors/%s/set-risk-attributes", id), map[string][]string{"riskAttributeIds": riskAttributeIDs}, &vendor); err != nil {
+		return nil, err
+	}
+
+	return vendor, nil
+}
@@ -47,7 +47,7 @@ func (c *RestClient) ListVulnerabilities(ctx context.Context, options *model.Lis
 		}
 	}
 
-	resp, err := c.makeRequest(ctx, "GET", "/v1/vulnerabilities", params)
+	resp, err := c.makeRequest(ctx, "GET", "/v1/vulnerabilities", params, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make request: %w", err)
 	}
@@ -71,7 +71,7 @@ func (c *RestClient) GetVulnerabilityByID(ctx context.Context, id string) (*mode
 		return nil, fmt.Errorf("vulnerability ID cannot be empty")
 	}
 
-	resp, err := c.makeRequest(ctx, "GET", fmt.Sprintf("/v1/vulnerabilities/%s", id), nil)
+	resp, err := c.makeRequest(ctx, "GET", fmt.Sprintf("/v1/vulnerabilities/%s", id), nil, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make request: %w", err)
 	}
@@ -88,3 +88,60 @@ func (c *RestClient) GetVulnerabilityByID(ctx context.Context, id string) (*mode
 
 	return vulnerability, nil
 }
+
+// DeactivateVulnerabilities deactivates vulnerabilities until a date, or indefinitely, with a reason.
+// Vulnerabilities are sent in batches; the outcome of each vulnerability is reported in the output.
+func (c *RestClient) DeactivateVulnerabilities(ctx context.Context, inputs []*model.DeactivateVulnerabilityInput) (*model.UpdateVulnerabilitiesOutput, error) {
+	type deactivateUpdate struct {
+		*model.DeactivateVulnerabilityInput
+		IsVulnDeactivatedIndefinitely bool `json:"isVulnDeactivatedIndefinitely"`
+	}
+
+	updates := make([]interface{}, 0, len(inputs))
+	for _, input := range inputs {
+		if input == nil || input.ID == "" {
+			return nil, fmt.Errorf("vulnerability ID cannot be empty")
+		}
+		if input.DeactivationReason == "" {
+			return nil, fmt.Errorf("deactivation reason cannot be empty for vulnerability %s", input.ID)
+		}
+		updates = append(updates, &deactivateUpdate{
+			DeactivateVulnerabilityInput:  input,
+			IsVulnDeactivatedIndefinitely: input.DeactivatedUntilDate == nil,
+		})
+	}
+
+	return sendBatches[*model.VulnerabilityUpdateResult](ctx, c, "POST", "/v1/vulnerabilities/deactivate", "updates", updates)
+}
+
+// ReactivateVulnerabilities reactivates previously deactivated vulnerabilities.
+// Vulnerabilities are sent in batches; the outcome of each vulnerability is reported in the output.
+func (c *RestClient) ReactivateVulnerabilities(ctx context.Context, ids []string) (*model.UpdateVulnerabilitiesOutput, error) {
+	type reactivateUpdate struct {
+		ID string `json:"id"`
+	}
+
+	updates := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		if id == "" {
+			return nil, fmt.Errorf("vulnerability ID cannot be empty")
+		}
+		updates = append(updates, &reactivateUpdate{ID: id})
+	}
+
+	return sendBatches[*model.VulnerabilityUpdateResult](ctx, c, "POST", "/v1/vulnerabilities/reactivate", "updates", updates)
+}
+
+// OverrideVulnerabilitySLAs sets the remediation deadline of vulnerabilities, overriding the SLA policy.
+// Vulnerabilities are sent in batches; the outcome of each vulnerability is reported in the output.
+func (c *RestClient) OverrideVulnerabilitySLAs(ctx context.Context, inputs []*model.OverrideVulnerabilitySLAInput) (*model.UpdateVulnerabilitiesOutput, error) {
+	updates := make([]interface{}, 0, len(inputs))
+	for _, input := range inputs {
+		if input == nil || input.ID == "" {
+			return nil, fmt.Errorf("vulnerability ID cannot be empty")
+		}
+		updates = append(updates, input)
+	}
+
+	return sendBatches[*model.VulnerabilityUpdateResult](ctx, c, "POST", "/v1/vulnerabilities/sla-override", "updates", updates)
+}
@@ -10,6 +10,8 @@ type vantaConfig struct {
 	AccessToken  *string `hcl:"access_token"`
 	RefreshToken *string `hcl:"refresh_token"`
 
+	ComputerStaleAfterDays *int `hcl:"computer_stale_after_days"`
+
 	ApiToken  *string `hcl:"api_token"`
 	SessionId *string `hcl:"session_id"` // This is the connect.sid cookie from a logged in Vanta browser session. Required to access tables that are using the deprecated https://app.vanta.com/graphql endpoint
 }
@@ -19,15 +19,28 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 		DefaultShouldIgnoreError: isNotFoundError([]string{"not found"}),
 		DefaultTransform:         transform.FromCamel().Transform(transform.NullIfZeroValue),
 		TableMap: map[string]*plugin.Table{
-			"vanta_computer":      tableVantaComputer(ctx),
-			"vanta_evidence":      tableVantaEvidence(ctx),
-			"vanta_group":         tableVantaGroup(ctx),
-			"vanta_integration":   tableVantaIntegration(ctx),
-			"vanta_monitor":       tableVantaMonitor(ctx),
-			"vanta_policy":        tableVantaPolicy(ctx),
-			"vanta_user":          tableVantaUser(ctx),
-			"vanta_vendor":        tableVantaVendor(ctx),
-			"vanta_vulnerability": tableVantaVulnerability(ctx),
+			"vanta_computer":                    tableVantaComputer(ctx),
+			"vanta_computer_application":        tableVantaComputerApplication(ctx),
+			"vanta_discovered_vendor":           tableVantaDiscoveredVendor(ctx),
+			"vanta_evidence":                    tableVantaEvidence(ctx),
+			"vanta_group":                       tableVantaGroup(ctx),
+			"vanta_integration":                 tableVantaIntegration(ctx),
+			"vanta_monitor":                     tableVantaMonitor(ctx),
+			"vanta_policy":                      tableVantaPolicy(ctx),
+			"vanta_policy_version":              tableVantaPolicyVersion(ctx),
+			"vanta_trust_center":                tableVantaTrustCenter(ctx),
+			"vanta_trust_center_access_request": tableVantaTrustCenterAccessRequest(ctx),
+			"vanta_trust_center_document":       tableVantaTrustCenterDocument(ctx),
+			"vanta_trust_center_subprocessor":   tableVantaTrustCenterSubprocessor(ctx),
+			"vanta_user":                        tableVantaUser(ctx),
+			"vanta_user_policy_acceptance":      tableVantaUserPolicyAcceptance(ctx),
+			"vanta_user_task":                   tableVantaUserTask(ctx),
+			"vanta_vendor":                      tableVantaVendor(ctx),
+			"vanta_vendor_custom_field":         tableVantaVendorCustomField(ctx),
+			"vanta_vendor_finding":              tableVantaVendorFinding(ctx),
+			"vanta_vendor_risk_attribute":       tableVantaVendorRiskAttribute(ctx),
+			"vanta_vendor_security_review":      tableVantaVendorSecurityReview(ctx),
+			"vanta_vulnerability":               tableVantaVulnerability(ctx),
 		},
 	}
 	return p
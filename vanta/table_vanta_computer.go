@@ -2,6 +2,7 @@ package vanta
 
 import (
 	"context"
+	"time"
 
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
@@ -17,6 +18,15 @@ func tableVantaComputer(ctx context.Context) *plugin.Table {
 		Description: "Vanta Computer",
 		List: &plugin.ListConfig{
 			Hydrate: listVantaComputers,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "owner_id", Require: plugin.Optional},
+				{Name: "os_type", Require: plugin.Optional},
+				{Name: "integration_id", Require: plugin.Optional},
+				{Name: "screenlock_outcome", Require: plugin.Optional},
+				{Name: "disk_encryption_outcome", Require: plugin.Optional},
+				{Name: "password_manager_outcome", Require: plugin.Optional},
+				{Name: "antivirus_installation_outcome", Require: plugin.Optional},
+			},
 		},
 		Get: &plugin.GetConfig{
 			Hydrate:    getVantaComputer,
@@ -39,10 +49,25 @@ func tableVantaComputer(ctx context.Context) *plugin.Table {
 			// Derived columns (available via transform from REST API)
 			{Name: "owner_name", Type: proto.ColumnType_STRING, Transform: transform.From(getOwnerName), Description: "The name of the workstation owner."},
 			{Name: "owner_id", Type: proto.ColumnType_STRING, Transform: transform.From(getOwnerID), Description: "A unique identifier of the owner of the workstation."},
+			{Name: "os_type", Type: proto.ColumnType_STRING, Transform: transform.FromField("OperatingSystem.Type"), Description: "The OS type of the workstation, e.g. MACOS, WINDOWS, LINUX."},
 			{Name: "os_version", Type: proto.ColumnType_STRING, Transform: transform.From(getOSVersion), Description: "The OS version of the workstation."},
 			{Name: "has_screen_lock", Type: proto.ColumnType_BOOL, Transform: transform.From(getScreenlockStatus), Description: "If true, the workstation has a screen lock configured."},
 			{Name: "is_encrypted", Type: proto.ColumnType_BOOL, Transform: transform.From(getDiskEncryptionStatus), Description: "If true, the workstation's hard drive is encrypted."},
 			{Name: "is_password_manager_installed", Type: proto.ColumnType_BOOL, Transform: transform.From(getPasswordManagerStatus), Description: "If true, a password manager is installed in the workstation."},
+			{Name: "has_antivirus", Type: proto.ColumnType_BOOL, Transform: transform.From(getAntivirusStatus), Description: "If true, antivirus software is installed in the workstation."},
+			{Name: "screenlock_outcome", Type: proto.ColumnType_STRING, Transform: transform.FromField("Screenlock.Outcome"), Description: "The outcome of the screenlock security check."},
+			{Name: "screenlock_last_check_date", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("Screenlock.LastCheckDate"), Description: "The time when the screenlock security check was last evaluated."},
+			{Name: "disk_encryption_outcome", Type: proto.ColumnType_STRING, Transform: transform.FromField("DiskEncryption.Outcome"), Description: "The outcome of the disk encryption security check."},
+			{Name: "disk_encryption_last_check_date", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("DiskEncryption.LastCheckDate"), Description: "The time when the disk encryption security check was last evaluated."},
+			{Name: "password_manager_outcome", Type: proto.ColumnType_STRING, Transform: transform.FromField("PasswordManager.Outcome"), Description: "The outcome of the password manager security check."},
+			{Name: "password_manager_last_check_date", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("PasswordManager.LastCheckDate"), Description: "The time when the password manager security check was last evaluated."},
+			{Name: "antivirus_installation_outcome", Type: proto.ColumnType_STRING, Transform: transform.FromField("AntivirusInstallation.Outcome"), Description: "The outcome of the antivirus installation security check."},
+			{Name: "antivirus_installation_last_check_date", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("AntivirusInstallation.LastCheckDate"), Description: "The time when the antivirus installation security check was last evaluated."},
+			{Name: "failing_checks", Type: proto.ColumnType_JSON, Transform: transform.From(getFailingChecks), Description: "List of security checks failing on the workstation: screenlock, disk_encryption, password_manager, antivirus_installation."},
+
+			// Staleness columns
+			{Name: "days_since_last_check", Type: proto.ColumnType_INT, Transform: transform.From(getDaysSinceLastCheck), Description: "Number of days since the workstation was last checked."},
+			{Name: "is_stale", Type: proto.ColumnType_BOOL, Hydrate: getComputerIsStale, Transform: transform.FromValue(), Description: "If true, the workstation has not been checked for longer than the computer_stale_after_days connection setting (defaults to 14 days)."},
 		},
 	}
 }
@@ -70,6 +95,29 @@ func listVantaComputers(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydr
 		Cursor: "",
 	}
 
+	// Apply optional filters from key columns
+	if d.EqualsQualString("owner_id") != "" {
+		options.OwnerID = d.EqualsQualString("owner_id")
+	}
+	if d.EqualsQualString("os_type") != "" {
+		options.OperatingSystemType = d.EqualsQualString("os_type")
+	}
+	if d.EqualsQualString("integration_id") != "" {
+		options.IntegrationID = d.EqualsQualString("integration_id")
+	}
+	if d.EqualsQualString("screenlock_outcome") != "" {
+		options.ScreenlockOutcome = d.EqualsQualString("screenlock_outcome")
+	}
+	if d.EqualsQualString("disk_encryption_outcome") != "" {
+		options.DiskEncryptionOutcome = d.EqualsQualString("disk_encryption_outcome")
+	}
+	if d.EqualsQualString("password_manager_outcome") != "" {
+		options.PasswordManagerOutcome = d.EqualsQualString("password_manager_outcome")
+	}
+	if d.EqualsQualString("antivirus_installation_outcome") != "" {
+		options.AntivirusInstallationOutcome = d.EqualsQualString("antivirus_installation_outcome")
+	}
+
 	for {
 		result, err := client.ListComputers(ctx, options)
 		if err != nil {
@@ -127,6 +175,31 @@ func getVantaComputer(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydrat
 	return computer, nil
 }
 
+//// HYDRATE FUNCTIONS
+
+// defaultComputerStaleAfterDays is used when computer_stale_after_days is not set in the connection config
+const defaultComputerStaleAfterDays = 14
+
+// getComputerIsStale determines if a computer has stopped reporting, based on the connection's staleness threshold
+func getComputerIsStale(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	computer, ok := h.Item.(*model.Computer)
+	if !ok {
+		return nil, nil
+	}
+
+	staleAfterDays := defaultComputerStaleAfterDays
+	if config := GetConfig(d.Connection); config.ComputerStaleAfterDays != nil {
+		staleAfterDays = *config.ComputerStaleAfterDays
+	}
+
+	// A computer that has never been checked is considered stale
+	if computer.LastCheckDate == nil {
+		return true, nil
+	}
+
+	return time.Since(*computer.LastCheckDate) > time.Duration(staleAfterDays)*24*time.Hour, nil
+}
+
 //// TRANSFORM FUNCTIONS
 
 // getOwnerName extracts the owner display name from the computer object
@@ -212,3 +285,60 @@ func getPasswordManagerStatus(ctx context.Context, d *transform.TransformData) (
 	}
 	return false, nil
 }
+
+// getAntivirusStatus determines if a computer has antivirus installed based on security check outcome
+func getAntivirusStatus(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	item := d.HydrateItem
+	computer, ok := item.(*model.Computer)
+	if !ok {
+		return false, nil
+	}
+
+	if computer.AntivirusInstallation != nil && computer.AntivirusInstallation.Outcome == "PASS" {
+		return true, nil
+	}
+	return false, nil
+}
+
+// getDaysSinceLastCheck calculates the number of days since the computer was last checked
+func getDaysSinceLastCheck(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	item := d.HydrateItem
+	computer, ok := item.(*model.Computer)
+	if !ok {
+		return nil, nil
+	}
+
+	if computer.LastCheckDate == nil {
+		return nil, nil
+	}
+
+	days := int(time.Since(*computer.LastCheckDate).Hours() / 24)
+	return days, nil
+}
+
+// getFailingChecks lists the security checks failing on the computer
+func getFailingChecks(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	item := d.HydrateItem
+	computer, ok := item.(*model.Computer)
+	if !ok {
+		return nil, nil
+	}
+
+	checks := []struct {
+		name  string
+		check *model.SecurityCheck
+	}{
+		{"screenlock", computer.Screenlock},
+		{"disk_encryption", computer.DiskEncryption},
+		{"password_manager", computer.PasswordManager},
+		{"antivirus_installation", computer.AntivirusInstallation},
+	}
+
+	failingChecks := []string{}
+	for _, c := range checks {
+		if c.check != nil && c.check.Outcome == "FAIL" {
+			failingChecks = append(failingChecks, c.name)
+		}
+	}
+	return failingChecks, nil
+}
@@ -0,0 +1,136 @@
+package vanta
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"github.com/turbot/steampipe-plugin-vanta/v2/rest_api/model"
+)
+
+// computerApplication represents an application installed on a specific computer
+type computerApplication struct {
+	ComputerID string
+	Name       string
+	Version    string
+	Publisher  string
+}
+
+//// TABLE DEFINITION
+
+func tableVantaComputerApplication(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "vanta_computer_application",
+		Description: "Vanta Computer Application",
+		List: &plugin.ListConfig{
+			Hydrate: listVantaComputerApplications,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "computer_id", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{Name: "computer_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("ComputerID"), Description: "The ID of the computer the application is installed on."},
+			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the application."},
+			{Name: "version", Type: proto.ColumnType_STRING, Description: "The installed version of the application."},
+			{Name: "publisher", Type: proto.ColumnType_STRING, Description: "The publisher of the application."},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listVantaComputerApplications(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create REST client
+	client, err := getClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("vanta_computer_application.listVantaComputerApplications", "connection_error", err)
+		return nil, err
+	}
+
+	computerIDs, err := getComputerIDsForQuery(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("vanta_computer_application.listVantaComputerApplications", "api_error", err)
+		return nil, err
+	}
+
+	for _, computerID := range computerIDs {
+		options := &model.ListComputerApplicationsOptions{
+			Limit:  100,
+			Cursor: "",
+		}
+
+		for {
+			result, err := client.ListComputerApplications(ctx, computerID, options)
+			if err != nil {
+				plugin.Logger(ctx).Error("vanta_computer_application.listVantaComputerApplications", "api_error", err)
+				return nil, err
+			}
+
+			for _, application := range result.Results.Data {
+				d.StreamListItem(ctx, &computerApplication{
+					ComputerID: computerID,
+					Name:       application.Name,
+					Version:    application.Version,
+					Publisher:  application.Publisher,
+				})
+
+				// Check if we should stop (limit reached or context cancelled)
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+
+			// Check if there are more pages
+			if !result.Results.PageInfo.HasNextPage {
+				break
+			}
+
+			// Set cursor for next page
+			options.Cursor = result.Results.PageInfo.EndCursor
+		}
+	}
+
+	return nil, nil
+}
+
+//// HELPER FUNCTIONS
+
+// getComputerIDsForQuery returns the computer ID from the computer_id qual, or the IDs of all computers
+func getComputerIDsForQuery(ctx context.Context, d *plugin.QueryData) ([]string, error) {
+	if computerID := d.EqualsQualString("computer_id"); computerID != "" {
+		return []string{computerID}, nil
+	}
+
+	client, err := getClient(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	options := &model.ListComputersOptions{
+		Limit:  100,
+		Cursor: "",
+	}
+
+	var computerIDs []string
+	for {
+		result, err := client.ListComputers(ctx, options)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, computer := range result.Results.Data {
+			computerIDs = append(computerIDs, computer.ID)
+		}
+
+		// Check if there are more pages
+		if !result.Results.PageInfo.HasNextPage {
+			break
+		}
+
+		// Set cursor for next page
+		options.Cursor = result.Results.PageInfo.EndCursor
+	}
+
+	return computerIDs, nil
+}
@@ -0,0 +1,98 @@
+package vanta
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"github.com/turbot/steampipe-plugin-vanta/v2/rest_api/model"
+)
+
+//// TABLE DEFINITION
+
+func tableVantaDiscoveredVendor(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "vanta_discovered_vendor",
+		Description: "Vanta Discovered Vendor",
+		List: &plugin.ListConfig{
+			Hydrate: listVantaDiscoveredVendors,
+		},
+		Columns: []*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Transform: transform.FromField("ID"), Description: "A unique identifier of the discovered vendor."},
+			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the discovered vendor."},
+			{Name: "source", Type: proto.ColumnType_STRING, Description: "The source the vendor was discovered from, e.g. an SSO or expense integration."},
+			{Name: "integration_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("IntegrationID"), Description: "The ID of the integration the vendor was discovered from."},
+			{Name: "first_seen_date", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("DiscoveredDate"), Description: "The date when the vendor was first discovered."},
+			{Name: "number_of_accounts", Type: proto.ColumnType_INT, Description: "The number of accounts discovered for the vendor."},
+			{Name: "vendor_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("VendorID"), Description: "The ID of the managed vendor this discovered vendor is linked to."},
+			{Name: "category", Type: proto.ColumnType_JSON, Description: "The category information of the discovered vendor."},
+
+			// Derived columns
+			{Name: "is_linked", Type: proto.ColumnType_BOOL, Transform: transform.From(getDiscoveredVendorIsLinked), Description: "If true, the discovered vendor is already linked to a vendor in vanta_vendor."},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listVantaDiscoveredVendors(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create REST client
+	client, err := getClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("vanta_discovered_vendor.listVantaDiscoveredVendors", "connection_error", err)
+		return nil, err
+	}
+
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	options := &model.ListDiscoveredVendorsOptions{
+		Limit:  int(maxLimit),
+		Cursor: "",
+	}
+
+	for {
+		result, err := client.ListDiscoveredVendors(ctx, options)
+		if err != nil {
+			plugin.Logger(ctx).Error("vanta_discovered_vendor.listVantaDiscoveredVendors", "api_error", err)
+			return nil, err
+		}
+
+		for _, vendor := range result.Results.Data {
+			d.StreamListItem(ctx, vendor)
+
+			// Check if we should stop (limit reached or context cancelled)
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		// Check if there are more pages
+		if !result.Results.PageInfo.HasNextPage {
+			break
+		}
+
+		// Set cursor for next page
+		options.Cursor = result.Results.PageInfo.EndCursor
+	}
+
+	return nil, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// getDiscoveredVendorIsLinked determines if a discovered vendor is linked to a managed vendor
+func getDiscoveredVendorIsLinked(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	vendor, ok := d.HydrateItem.(*model.DiscoveredVendor)
+	if !ok {
+		return false, nil
+	}
+
+	return vendor.VendorID != nil && *vendor.VendorID != "", nil
+}
@@ -23,12 +23,20 @@ func tableVantaPolicy(ctx context.Context) *plugin.Table {
 			KeyColumns: plugin.SingleColumn("id"),
 		},
 		Columns: []*plugin.Column{
-			{Name: "title", Type: proto.ColumnType_STRING, Description: "The title of the policy."},
+			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the policy."},
 			{Name: "id", Type: proto.ColumnType_STRING, Transform: transform.FromField("ID"), Description: "A unique identifier of the policy."},
 			{Name: "description", Type: proto.ColumnType_STRING, Description: "A human-readable description of the policy."},
 			{Name: "status", Type: proto.ColumnType_STRING, Description: "The current status of the policy."},
 			{Name: "approved_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("ApprovedAtDate"), Description: "The time when the policy was approved."},
 			{Name: "latest_version_status", Type: proto.ColumnType_STRING, Transform: transform.FromField("LatestVersion.Status"), Description: "The status of the latest version of the policy."},
+
+			// Acceptance coverage across active employees
+			{Name: "accepted_count", Type: proto.ColumnType_INT, Hydrate: getVantaPolicyAcceptance, Transform: transform.FromField("Accepted"), Description: "Number of active employees who have accepted the policy."},
+			{Name: "pending_count", Type: proto.ColumnType_INT, Hydrate: getVantaPolicyAcceptance, Transform: transform.FromField("Pending"), Description: "Number of active employees who have not yet accepted the policy."},
+			{Name: "acceptance_rate", Type: proto.ColumnType_DOUBLE, Hydrate: getVantaPolicyAcceptance, Transform: transform.From(getPolicyAcceptanceRate), Description: "Percentage of active employees assigned the policy who have accepted it."},
+
+			// Backward compatibility columns (mapped from REST API data)
+			{Name: "title", Type: proto.ColumnType_STRING, Transform: transform.FromField("Name"), Description: "The title of the policy (same as name)."},
 		},
 	}
 }
@@ -113,3 +121,106 @@ func getVantaPolicy(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateD
 	// Return the raw PolicyItem object
 	return policy, nil
 }
+
+//// HYDRATE FUNCTIONS
+
+// policyAcceptance holds the number of active employees who have and have not accepted a policy
+type policyAcceptance struct {
+	Accepted int
+	Pending  int
+}
+
+// getVantaPolicyAcceptance returns the acceptance counts of a policy
+func getVantaPolicyAcceptance(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	policy, ok := h.Item.(*model.PolicyItem)
+	if !ok {
+		return nil, nil
+	}
+
+	result, err := getPolicyAcceptancesByNameMemoized(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("vanta_policy.getVantaPolicyAcceptance", "api_error", err)
+		return nil, err
+	}
+
+	if acceptance, ok := result.(map[string]*policyAcceptance)[policy.Name]; ok {
+		return acceptance, nil
+	}
+	return &policyAcceptance{}, nil
+}
+
+// getPolicyAcceptancesByNameMemoized caches the aggregate per connection so it is computed once rather than per policy
+var getPolicyAcceptancesByNameMemoized = plugin.HydrateFunc(getPolicyAcceptancesByName).Memoize()
+
+// getPolicyAcceptancesByName aggregates the policy acceptance tasks of all active employees, keyed by policy name
+func getPolicyAcceptancesByName(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := getClient(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	options := &model.ListPeopleOptions{
+		Limit:            100,
+		Cursor:           "",
+		EmploymentStatus: string(model.EmploymentStatusCurrent),
+	}
+
+	acceptances := map[string]*policyAcceptance{}
+	get := func(name string) *policyAcceptance {
+		if _, ok := acceptances[name]; !ok {
+			acceptances[name] = &policyAcceptance{}
+		}
+		return acceptances[name]
+	}
+
+	for {
+		result, err := client.ListPeople(ctx, options)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, person := range result.Results.Data {
+			// The employment status filter is also applied here, so former employees are never counted
+			if person.Employment == nil || person.Employment.Status == nil || *person.Employment.Status != model.EmploymentStatusCurrent {
+				continue
+			}
+			if person.TasksSummary == nil || person.TasksSummary.Details.AcceptPolicies == nil {
+				continue
+			}
+			task := person.TasksSummary.Details.AcceptPolicies
+			for _, p := range task.AcceptedPolicies {
+				get(p.Name).Accepted++
+			}
+			for _, p := range task.UnacceptedPolicies {
+				get(p.Name).Pending++
+			}
+		}
+
+		// Check if there are more pages
+		if !result.Results.PageInfo.HasNextPage {
+			break
+		}
+
+		// Set cursor for next page
+		options.Cursor = result.Results.PageInfo.EndCursor
+	}
+
+	return acceptances, nil
+}
+
+//// TRANSFORM FUNCTIONS
+
+// getPolicyAcceptanceRate calculates the percentage of assigned active employees who accepted the policy
+func getPolicyAcceptanceRate(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	acceptance, ok := d.HydrateItem.(*policyAcceptance)
+	if !ok {
+		return nil, nil
+	}
+
+	total := acceptance.Accepted + acceptance.Pending
+	if total == 0 {
+		return nil, nil
+	}
+
+	return float64(acceptance.Accepted) * 100 / float64(total), nil
+}
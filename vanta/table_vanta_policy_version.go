@@ -0,0 +1,131 @@
+package vanta
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"github.com/turbot/steampipe-plugin-vanta/v2/rest_api/model"
+)
+
+//// TABLE DEFINITION
+
+func tableVantaPolicyVersion(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "vanta_policy_version",
+		Description: "Vanta Policy Version",
+		List: &plugin.ListConfig{
+			Hydrate: listVantaPolicyVersions,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "policy_id", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Transform: transform.FromField("ID"), Description: "A unique identifier of the policy version."},
+			{Name: "policy_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("PolicyID"), Description: "The ID of the policy."},
+			{Name: "version_number", Type: proto.ColumnType_INT, Description: "The version number of the policy document."},
+			{Name: "status", Type: proto.ColumnType_STRING, Description: "The status of the policy version."},
+			{Name: "approver_user_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("ApproverUserID"), Description: "The user ID of the person who approved the policy version."},
+			{Name: "approved_at", Type: proto.ColumnType_TIMESTAMP, Transform: transform.FromField("ApprovedAtDate"), Description: "The time when the policy version was approved."},
+			{Name: "effective_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date from which the policy version is effective."},
+			{Name: "creation_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date when the policy version was created."},
+			{Name: "document_url", Type: proto.ColumnType_STRING, Transform: transform.FromField("DocumentURL"), Description: "The URL of the policy document."},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listVantaPolicyVersions(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create REST client
+	client, err := getClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("vanta_policy_version.listVantaPolicyVersions", "connection_error", err)
+		return nil, err
+	}
+
+	policyIDs, err := getPolicyIDsForQuery(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("vanta_policy_version.listVantaPolicyVersions", "api_error", err)
+		return nil, err
+	}
+
+	for _, policyID := range policyIDs {
+		options := &model.ListPolicyVersionsOptions{
+			Limit:  100,
+			Cursor: "",
+		}
+
+		for {
+			result, err := client.ListPolicyVersions(ctx, policyID, options)
+			if err != nil {
+				plugin.Logger(ctx).Error("vanta_policy_version.listVantaPolicyVersions", "api_error", err)
+				return nil, err
+			}
+
+			for _, version := range result.Results.Data {
+				if version.PolicyID == "" {
+					version.PolicyID = policyID
+				}
+				d.StreamListItem(ctx, version)
+
+				// Check if we should stop (limit reached or context cancelled)
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+
+			// Check if there are more pages
+			if !result.Results.PageInfo.HasNextPage {
+				break
+			}
+
+			// Set cursor for next page
+			options.Cursor = result.Results.PageInfo.EndCursor
+		}
+	}
+
+	return nil, nil
+}
+
+//// HELPER FUNCTIONS
+
+// getPolicyIDsForQuery returns the policy ID from the policy_id qual, or the IDs of all policies
+func getPolicyIDsForQuery(ctx context.Context, d *plugin.QueryData) ([]string, error) {
+	if policyID := d.EqualsQualString("policy_id"); policyID != "" {
+		return []string{policyID}, nil
+	}
+
+	client, err := getClient(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	options := &model.ListPoliciesOptions{
+		Limit:  100,
+		Cursor: "",
+	}
+
+	var policyIDs []string
+	for {
+		result, err := client.ListPolicies(ctx, options)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, policy := range result.Results.Data {
+			policyIDs = append(policyIDs, policy.ID)
+		}
+
+		// Check if there are more pages
+		if !result.Results.PageInfo.HasNextPage {
+			break
+		}
+
+		// Set cursor for next page
+		options.Cursor = result.Results.PageInfo.EndCursor
+	}
+
+	return policyIDs, nil
+}
@@ -0,0 +1,62 @@
+package vanta
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+)
+
+//// TABLE DEFINITION
+
+func tableVantaTrustCenter(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "vanta_trust_center",
+		Description: "Vanta Trust Center",
+		Get: &plugin.GetConfig{
+			Hydrate:    getVantaTrustCenter,
+			KeyColumns: plugin.SingleColumn("slug_id"),
+		},
+		Columns: []*plugin.Column{
+			{Name: "slug_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("SlugID"), Description: "The slug identifying the trust center, as used in its URL."},
+			{Name: "id", Type: proto.ColumnType_STRING, Transform: transform.FromField("ID"), Description: "A unique identifier of the trust center."},
+			{Name: "title", Type: proto.ColumnType_STRING, Description: "The title displayed on the trust center."},
+			{Name: "company_name", Type: proto.ColumnType_STRING, Description: "The name of the company the trust center belongs to."},
+			{Name: "company_description", Type: proto.ColumnType_STRING, Description: "The description of the company shown on the trust center."},
+			{Name: "is_public", Type: proto.ColumnType_BOOL, Transform: transform.FromField("IsPublic"), Description: "If true, the trust center is publicly accessible."},
+			{Name: "custom_domain", Type: proto.ColumnType_STRING, Description: "The custom domain the trust center is served from."},
+			{Name: "privacy_policy_url", Type: proto.ColumnType_STRING, Transform: transform.FromField("PrivacyPolicyURL"), Description: "The URL of the privacy policy linked from the trust center."},
+			{Name: "creation_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date when the trust center was created."},
+			{Name: "updated_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date when the trust center was last updated."},
+		},
+	}
+}
+
+//// GET FUNCTION
+
+func getVantaTrustCenter(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	slugID := d.EqualsQualString("slug_id")
+	if slugID == "" {
+		return nil, nil
+	}
+
+	// Create REST client
+	client, err := getClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("vanta_trust_center.getVantaTrustCenter", "connection_error", err)
+		return nil, err
+	}
+
+	trustCenter, err := client.GetTrustCenter(ctx, slugID)
+	if err != nil {
+		plugin.Logger(ctx).Error("vanta_trust_center.getVantaTrustCenter", "api_error", err)
+		return nil, err
+	}
+
+	if trustCenter == nil {
+		return nil, nil
+	}
+
+	return trustCenter, nil
+}
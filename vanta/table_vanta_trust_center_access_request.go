@@ -0,0 +1,97 @@
+package vanta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"github.com/turbot/steampipe-plugin-vanta/v2/rest_api/model"
+)
+
+//// TABLE DEFINITION
+
+func tableVantaTrustCenterAccessRequest(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "vanta_trust_center_access_request",
+		Description: "Vanta Trust Center Access Request",
+		List: &plugin.ListConfig{
+			Hydrate: listVantaTrustCenterAccessRequests,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "slug_id", Require: plugin.Required},
+				{Name: "status", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			// Required parameters
+			{Name: "slug_id", Type: proto.ColumnType_STRING, Transform: transform.FromQual("slug_id"), Description: "The slug of the trust center (required parameter)."},
+
+			{Name: "id", Type: proto.ColumnType_STRING, Transform: transform.FromField("ID"), Description: "A unique identifier of the access request."},
+			{Name: "status", Type: proto.ColumnType_STRING, Description: "The status of the access request, e.g. PENDING, APPROVED, DENIED, REVOKED."},
+			{Name: "requester_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("Name"), Description: "The name of the person who requested access."},
+			{Name: "requester_email", Type: proto.ColumnType_STRING, Transform: transform.FromField("Email"), Description: "The email address of the person who requested access."},
+			{Name: "requester_company_name", Type: proto.ColumnType_STRING, Transform: transform.FromField("CompanyName"), Description: "The company of the person who requested access."},
+			{Name: "reason", Type: proto.ColumnType_STRING, Description: "The reason given for the access request."},
+			{Name: "document_ids", Type: proto.ColumnType_JSON, Transform: transform.FromField("DocumentIDs"), Description: "The IDs of the trust center documents access was requested for."},
+			{Name: "reviewer_user_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("ReviewerUserID"), Description: "The ID of the user who reviewed the access request."},
+			{Name: "creation_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date when access was requested."},
+			{Name: "reviewed_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date when the access request was reviewed."},
+			{Name: "expiration_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date when granted access expires."},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listVantaTrustCenterAccessRequests(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Get slug_id from required qualifier
+	slugID := d.EqualsQualString("slug_id")
+	if slugID == "" {
+		return nil, fmt.Errorf("slug_id is required")
+	}
+
+	// Create REST client
+	client, err := getClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("vanta_trust_center_access_request.listVantaTrustCenterAccessRequests", "connection_error", err)
+		return nil, err
+	}
+
+	options := &model.ListTrustCenterAccessRequestsOptions{
+		Limit:  100,
+		Cursor: "",
+	}
+
+	// Apply optional filters from key columns
+	if d.EqualsQualString("status") != "" {
+		options.Status = d.EqualsQualString("status")
+	}
+
+	for {
+		result, err := client.ListTrustCenterAccessRequests(ctx, slugID, options)
+		if err != nil {
+			plugin.Logger(ctx).Error("vanta_trust_center_access_request.listVantaTrustCenterAccessRequests", "api_error", err)
+			return nil, err
+		}
+
+		for _, request := range result.Results.Data {
+			d.StreamListItem(ctx, request)
+
+			// Check if we should stop (limit reached or context cancelled)
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		// Check if there are more pages
+		if !result.Results.PageInfo.HasNextPage {
+			break
+		}
+
+		// Set cursor for next page
+		options.Cursor = result.Results.PageInfo.EndCursor
+	}
+
+	return nil, nil
+}
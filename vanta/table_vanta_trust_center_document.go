@@ -0,0 +1,89 @@
+package vanta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"github.com/turbot/steampipe-plugin-vanta/v2/rest_api/model"
+)
+
+//// TABLE DEFINITION
+
+func tableVantaTrustCenterDocument(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "vanta_trust_center_document",
+		Description: "Vanta Trust Center Document",
+		List: &plugin.ListConfig{
+			Hydrate: listVantaTrustCenterDocuments,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "slug_id", Require: plugin.Required},
+			},
+		},
+		Columns: []*plugin.Column{
+			// Required parameters
+			{Name: "slug_id", Type: proto.ColumnType_STRING, Transform: transform.FromQual("slug_id"), Description: "The slug of the trust center (required parameter)."},
+
+			{Name: "id", Type: proto.ColumnType_STRING, Transform: transform.FromField("ID"), Description: "A unique identifier of the document."},
+			{Name: "title", Type: proto.ColumnType_STRING, Description: "The title of the document."},
+			{Name: "description", Type: proto.ColumnType_STRING, Description: "The description of the document."},
+			{Name: "category", Type: proto.ColumnType_STRING, Description: "The category the document is listed under."},
+			{Name: "access_level", Type: proto.ColumnType_STRING, Description: "Who can access the document, e.g. PUBLIC, REQUIRES_NDA, PRIVATE."},
+			{Name: "is_published", Type: proto.ColumnType_BOOL, Transform: transform.FromField("IsPublished"), Description: "If true, the document is visible on the trust center."},
+			{Name: "file_name", Type: proto.ColumnType_STRING, Description: "The file name of the document."},
+			{Name: "creation_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date when the document was added."},
+			{Name: "updated_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date when the document was last updated."},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listVantaTrustCenterDocuments(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Get slug_id from required qualifier
+	slugID := d.EqualsQualString("slug_id")
+	if slugID == "" {
+		return nil, fmt.Errorf("slug_id is required")
+	}
+
+	// Create REST client
+	client, err := getClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("vanta_trust_center_document.listVantaTrustCenterDocuments", "connection_error", err)
+		return nil, err
+	}
+
+	options := &model.ListTrustCenterDocumentsOptions{
+		Limit:  100,
+		Cursor: "",
+	}
+
+	for {
+		result, err := client.ListTrustCenterDocuments(ctx, slugID, options)
+		if err != nil {
+			plugin.Logger(ctx).Error("vanta_trust_center_document.listVantaTrustCenterDocuments", "api_error", err)
+			return nil, err
+		}
+
+		for _, document := range result.Results.Data {
+			d.StreamListItem(ctx, document)
+
+			// Check if we should stop (limit reached or context cancelled)
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		// Check if there are more pages
+		if !result.Results.PageInfo.HasNextPage {
+			break
+		}
+
+		// Set cursor for next page
+		options.Cursor = result.Results.PageInfo.EndCursor
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,87 @@
+package vanta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"github.com/turbot/steampipe-plugin-vanta/v2/rest_api/model"
+)
+
+//// TABLE DEFINITION
+
+func tableVantaTrustCenterSubprocessor(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "vanta_trust_center_subprocessor",
+		Description: "Vanta Trust Center Subprocessor",
+		List: &plugin.ListConfig{
+			Hydrate: listVantaTrustCenterSubprocessors,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "slug_id", Require: plugin.Required},
+			},
+		},
+		Columns: []*plugin.Column{
+			// Required parameters
+			{Name: "slug_id", Type: proto.ColumnType_STRING, Transform: transform.FromQual("slug_id"), Description: "The slug of the trust center (required parameter)."},
+
+			{Name: "id", Type: proto.ColumnType_STRING, Transform: transform.FromField("ID"), Description: "A unique identifier of the subprocessor."},
+			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the subprocessor."},
+			{Name: "purpose", Type: proto.ColumnType_STRING, Description: "The purpose for which the subprocessor processes data."},
+			{Name: "location", Type: proto.ColumnType_STRING, Description: "The location where the subprocessor processes data."},
+			{Name: "website_url", Type: proto.ColumnType_STRING, Transform: transform.FromField("WebsiteURL"), Description: "The website of the subprocessor."},
+			{Name: "creation_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date when the subprocessor was added."},
+			{Name: "updated_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date when the subprocessor was last updated."},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listVantaTrustCenterSubprocessors(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Get slug_id from required qualifier
+	slugID := d.EqualsQualString("slug_id")
+	if slugID == "" {
+		return nil, fmt.Errorf("slug_id is required")
+	}
+
+	// Create REST client
+	client, err := getClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("vanta_trust_center_subprocessor.listVantaTrustCenterSubprocessors", "connection_error", err)
+		return nil, err
+	}
+
+	options := &model.ListTrustCenterSubprocessorsOptions{
+		Limit:  100,
+		Cursor: "",
+	}
+
+	for {
+		result, err := client.ListTrustCenterSubprocessors(ctx, slugID, options)
+		if err != nil {
+			plugin.Logger(ctx).Error("vanta_trust_center_subprocessor.listVantaTrustCenterSubprocessors", "api_error", err)
+			return nil, err
+		}
+
+		for _, subprocessor := range result.Results.Data {
+			d.StreamListItem(ctx, subprocessor)
+
+			// Check if we should stop (limit reached or context cancelled)
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		// Check if there are more pages
+		if !result.Results.PageInfo.HasNextPage {
+			break
+		}
+
+		// Set cursor for next page
+		options.Cursor = result.Results.PageInfo.EndCursor
+	}
+
+	return nil, nil
+}
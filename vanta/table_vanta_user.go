@@ -19,6 +19,9 @@ func tableVantaUser(ctx context.Context) *plugin.Table {
 			Hydrate: listVantaUsers,
 			KeyColumns: plugin.KeyColumnSlice{
 				{Name: "employment_status", Require: plugin.Optional},
+				{Name: "task_status", Require: plugin.Optional},
+				{Name: "email", Require: plugin.Optional},
+				{Name: "group_id", Require: plugin.Optional},
 			},
 		},
 		Get: &plugin.GetConfig{
@@ -42,6 +45,9 @@ func tableVantaUser(ctx context.Context) *plugin.Table {
 			{Name: "name", Type: proto.ColumnType_JSON, Description: "Name information including display, first, and last name."},
 			{Name: "sources", Type: proto.ColumnType_JSON, Description: "Information about data sources for this user."},
 			{Name: "tasks_summary", Type: proto.ColumnType_JSON, Description: "Summary of security task completion status."},
+
+			// Filter-only columns
+			{Name: "group_id", Type: proto.ColumnType_STRING, Transform: transform.FromQual("group_id"), Description: "A group ID to filter users by group membership."},
 		},
 	}
 }
@@ -64,14 +70,25 @@ func listVantaUsers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateD
 		}
 	}
 
-	// Check for employment status filter
-	// employmentStatusFilter := d.EqualsQualString("employment_status")
-
 	options := &model.ListPeopleOptions{
 		Limit:  int(maxLimit),
 		Cursor: "",
 	}
 
+	// Apply optional filters from key columns
+	if d.EqualsQualString("employment_status") != "" {
+		options.EmploymentStatus = d.EqualsQualString("employment_status")
+	}
+	if d.EqualsQualString("task_status") != "" {
+		options.TaskStatus = d.EqualsQualString("task_status")
+	}
+	if d.EqualsQualString("email") != "" {
+		options.EmailAddress = d.EqualsQualString("email")
+	}
+	if d.EqualsQualString("group_id") != "" {
+		options.GroupID = d.EqualsQualString("group_id")
+	}
+
 	for {
 		result, err := client.ListPeople(ctx, options)
 		if err != nil {
@@ -0,0 +1,248 @@
+package vanta
+
+import (
+	"context"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"github.com/turbot/steampipe-plugin-vanta/v2/rest_api"
+	"github.com/turbot/steampipe-plugin-vanta/v2/rest_api/model"
+)
+
+// userPolicyAcceptance represents the acceptance state of a single policy by a person
+type userPolicyAcceptance struct {
+	UserID         string
+	UserEmail      string
+	DisplayName    string
+	PolicyName     string
+	IsAccepted     bool
+	DueDate        *time.Time
+	CompletionDate *time.Time
+}
+
+//// TABLE DEFINITION
+
+func tableVantaUserPolicyAcceptance(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "vanta_user_policy_acceptance",
+		Description: "Vanta User Policy Acceptance",
+		List: &plugin.ListConfig{
+			Hydrate: listVantaUserPolicyAcceptances,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "user_id", Require: plugin.Optional},
+				{Name: "policy_name", Require: plugin.Optional},
+				{Name: "is_accepted", Require: plugin.Optional, Operators: []string{"=", "<>"}},
+			},
+		},
+		Columns: []*plugin.Column{
+			{Name: "user_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("UserID"), Description: "The ID of the user the policy is assigned to."},
+			{Name: "user_email", Type: proto.ColumnType_STRING, Description: "The email of the user the policy is assigned to."},
+			{Name: "display_name", Type: proto.ColumnType_STRING, Description: "The display name of the user the policy is assigned to."},
+			{Name: "policy_name", Type: proto.ColumnType_STRING, Description: "The name of the policy."},
+			{Name: "policy_id", Type: proto.ColumnType_STRING, Hydrate: getUserPolicyAcceptancePolicyID, Transform: transform.FromValue(), Description: "The ID of the policy, resolved from vanta_policy by name. Null if no policy or more than one policy has that name."},
+			{Name: "is_accepted", Type: proto.ColumnType_BOOL, Transform: transform.FromField("IsAccepted"), Description: "If true, the user has accepted the policy."},
+			{Name: "due_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date by which the user must accept the assigned policies."},
+			{Name: "completion_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date when the user accepted all assigned policies."},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listVantaUserPolicyAcceptances(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create REST client
+	client, err := getClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("vanta_user_policy_acceptance.listVantaUserPolicyAcceptances", "connection_error", err)
+		return nil, err
+	}
+
+	// Fetch a single person if the user ID is known
+	if userID := d.EqualsQualString("user_id"); userID != "" {
+		person, err := client.GetPersonByID(ctx, userID)
+		if rest_api.IsNotFound(err) {
+			// The user does not exist or has been removed
+			return nil, nil
+		}
+		if err != nil {
+			plugin.Logger(ctx).Error("vanta_user_policy_acceptance.listVantaUserPolicyAcceptances", "api_error", err)
+			return nil, err
+		}
+		if person != nil {
+			streamUserPolicyAcceptances(ctx, d, person)
+		}
+		return nil, nil
+	}
+
+	options := &model.ListPeopleOptions{
+		Limit:  100,
+		Cursor: "",
+	}
+
+	for {
+		result, err := client.ListPeople(ctx, options)
+		if err != nil {
+			plugin.Logger(ctx).Error("vanta_user_policy_acceptance.listVantaUserPolicyAcceptances", "api_error", err)
+			return nil, err
+		}
+
+		for _, person := range result.Results.Data {
+			if !streamUserPolicyAcceptances(ctx, d, person) {
+				return nil, nil
+			}
+		}
+
+		// Check if there are more pages
+		if !result.Results.PageInfo.HasNextPage {
+			break
+		}
+
+		// Set cursor for next page
+		options.Cursor = result.Results.PageInfo.EndCursor
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+// getUserPolicyAcceptancePolicyID resolves the policy ID from the policy name, if exactly one policy has that name
+func getUserPolicyAcceptancePolicyID(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	acceptance, ok := h.Item.(*userPolicyAcceptance)
+	if !ok {
+		return nil, nil
+	}
+
+	policies, err := getPoliciesByNameMemoized(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("vanta_user_policy_acceptance.getUserPolicyAcceptancePolicyID", "api_error", err)
+		return nil, err
+	}
+
+	// Acceptance tasks only carry the policy name, so the ID is left null if the name is ambiguous
+	if matches := policies.(map[string][]*model.PolicyItem)[acceptance.PolicyName]; len(matches) == 1 {
+		return matches[0].ID, nil
+	}
+	return nil, nil
+}
+
+// getPoliciesByNameMemoized caches the policy lookup per connection
+var getPoliciesByNameMemoized = plugin.HydrateFunc(getPoliciesByName).Memoize()
+
+// getPoliciesByName returns all policies grouped by policy name
+func getPoliciesByName(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := getClient(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	options := &model.ListPoliciesOptions{
+		Limit:  100,
+		Cursor: "",
+	}
+
+	policies := map[string][]*model.PolicyItem{}
+	for {
+		result, err := client.ListPolicies(ctx, options)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, policy := range result.Results.Data {
+			policies[policy.Name] = append(policies[policy.Name], policy)
+		}
+
+		// Check if there are more pages
+		if !result.Results.PageInfo.HasNextPage {
+			break
+		}
+
+		// Set cursor for next page
+		options.Cursor = result.Results.PageInfo.EndCursor
+	}
+
+	return policies, nil
+}
+
+//// HELPER FUNCTIONS
+
+// streamUserPolicyAcceptances streams one row per policy assigned to the given person.
+// Returns false once no more rows are required.
+func streamUserPolicyAcceptances(ctx context.Context, d *plugin.QueryData, person *model.Person) bool {
+	for _, acceptance := range getUserPolicyAcceptances(person) {
+		if shouldFilterUserPolicyAcceptance(d, acceptance) {
+			continue
+		}
+
+		d.StreamListItem(ctx, acceptance)
+
+		// Check if we should stop (limit reached or context cancelled)
+		if d.RowsRemaining(ctx) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// getUserPolicyAcceptances flattens the accepted and unaccepted policies of a person
+func getUserPolicyAcceptances(person *model.Person) []*userPolicyAcceptance {
+	if person.TasksSummary == nil || person.TasksSummary.Details.AcceptPolicies == nil {
+		return nil
+	}
+	task := person.TasksSummary.Details.AcceptPolicies
+
+	var displayName string
+	if person.Name != nil {
+		displayName = person.Name.Display
+	}
+
+	var result []*userPolicyAcceptance
+	add := func(policies []model.Policy, accepted bool) {
+		for _, policy := range policies {
+			result = append(result, &userPolicyAcceptance{
+				UserID:         person.ID,
+				UserEmail:      person.EmailAddress,
+				DisplayName:    displayName,
+				PolicyName:     policy.Name,
+				IsAccepted:     accepted,
+				DueDate:        task.DueDate,
+				CompletionDate: task.CompletionDate,
+			})
+		}
+	}
+	add(task.AcceptedPolicies, true)
+	add(task.UnacceptedPolicies, false)
+
+	return result
+}
+
+// shouldFilterUserPolicyAcceptance applies optional filters
+func shouldFilterUserPolicyAcceptance(d *plugin.QueryData, acceptance *userPolicyAcceptance) bool {
+	// Filter by policy name
+	if policyName := d.EqualsQualString("policy_name"); policyName != "" {
+		if acceptance.PolicyName != policyName {
+			return true
+		}
+	}
+
+	// Filter by acceptance state
+	if d.Quals["is_accepted"] != nil {
+		for _, q := range d.Quals["is_accepted"].Quals {
+			value := q.Value.GetBoolValue()
+			switch q.Operator {
+			case "=":
+				if acceptance.IsAccepted != value {
+					return true
+				}
+			case "<>":
+				if acceptance.IsAccepted == value {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
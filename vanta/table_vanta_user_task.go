@@ -0,0 +1,202 @@
+package vanta
+
+import (
+	"context"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"github.com/turbot/steampipe-plugin-vanta/v2/rest_api"
+	"github.com/turbot/steampipe-plugin-vanta/v2/rest_api/model"
+)
+
+// userTask represents a single security task assigned to a person
+type userTask struct {
+	UserID         string
+	UserEmail      string
+	DisplayName    string
+	TaskType       string
+	Status         string
+	DueDate        *time.Time
+	CompletionDate *time.Time
+	DisabledDate   *time.Time
+	DisabledReason string
+}
+
+//// TABLE DEFINITION
+
+func tableVantaUserTask(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "vanta_user_task",
+		Description: "Vanta User Task",
+		List: &plugin.ListConfig{
+			Hydrate: listVantaUserTasks,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "user_id", Require: plugin.Optional},
+				{Name: "task_type", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{Name: "user_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("UserID"), Description: "The ID of the user the task is assigned to."},
+			{Name: "user_email", Type: proto.ColumnType_STRING, Description: "The email of the user the task is assigned to."},
+			{Name: "display_name", Type: proto.ColumnType_STRING, Description: "The display name of the user the task is assigned to."},
+			{Name: "task_type", Type: proto.ColumnType_STRING, Description: "The type of the security task, e.g. COMPLETE_TRAININGS, ACCEPT_POLICIES."},
+			{Name: "status", Type: proto.ColumnType_STRING, Description: "The status of the security task."},
+			{Name: "due_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date by which the task is due."},
+			{Name: "completion_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date when the task was completed."},
+			{Name: "is_disabled", Type: proto.ColumnType_BOOL, Transform: transform.From(getUserTaskIsDisabled), Description: "If true, the task has been disabled for the user."},
+			{Name: "disabled_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date when the task was disabled for the user."},
+			{Name: "disabled_reason", Type: proto.ColumnType_STRING, Description: "The reason the task was disabled for the user."},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listVantaUserTasks(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create REST client
+	client, err := getClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("vanta_user_task.listVantaUserTasks", "connection_error", err)
+		return nil, err
+	}
+
+	taskTypeFilter := d.EqualsQualString("task_type")
+
+	// Fetch a single person if the user ID is known
+	if userID := d.EqualsQualString("user_id"); userID != "" {
+		person, err := client.GetPersonByID(ctx, userID)
+		if rest_api.IsNotFound(err) {
+			// The user does not exist or has been removed
+			return nil, nil
+		}
+		if err != nil {
+			plugin.Logger(ctx).Error("vanta_user_task.listVantaUserTasks", "api_error", err)
+			return nil, err
+		}
+		if person != nil {
+			streamUserTasks(ctx, d, person, taskTypeFilter)
+		}
+		return nil, nil
+	}
+
+	options := &model.ListPeopleOptions{
+		Limit:  100,
+		Cursor: "",
+	}
+
+	for {
+		result, err := client.ListPeople(ctx, options)
+		if err != nil {
+			plugin.Logger(ctx).Error("vanta_user_task.listVantaUserTasks", "api_error", err)
+			return nil, err
+		}
+
+		for _, person := range result.Results.Data {
+			if !streamUserTasks(ctx, d, person, taskTypeFilter) {
+				return nil, nil
+			}
+		}
+
+		// Check if there are more pages
+		if !result.Results.PageInfo.HasNextPage {
+			break
+		}
+
+		// Set cursor for next page
+		options.Cursor = result.Results.PageInfo.EndCursor
+	}
+
+	return nil, nil
+}
+
+//// HELPER FUNCTIONS
+
+// streamUserTasks streams one row per task type of the given person.
+// Returns false once no more rows are required.
+func streamUserTasks(ctx context.Context, d *plugin.QueryData, person *model.Person, taskTypeFilter string) bool {
+	for _, task := range getUserTasks(person) {
+		if taskTypeFilter != "" && task.TaskType != taskTypeFilter {
+			continue
+		}
+
+		d.StreamListItem(ctx, task)
+
+		// Check if we should stop (limit reached or context cancelled)
+		if d.RowsRemaining(ctx) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// getUserTasks flattens the task details of a person into individual tasks
+func getUserTasks(person *model.Person) []*userTask {
+	if person.TasksSummary == nil {
+		return nil
+	}
+
+	details := person.TasksSummary.Details
+	tasks := []struct {
+		taskType model.TaskType
+		detail   *model.TaskDetail
+	}{
+		{model.TaskTypeCompleteTrainings, details.CompleteTrainings},
+		{model.TaskTypeCompleteCustomTasks, details.CompleteCustomTasks},
+		{model.TaskTypeCompleteOffboardingCustomTasks, details.CompleteOffboardingCustomTasks},
+		{model.TaskTypeCompleteBackgroundChecks, details.CompleteBackgroundChecks},
+		{model.TaskTypeInstallDeviceMonitoring, details.InstallDeviceMonitoring},
+	}
+
+	var result []*userTask
+	for _, t := range tasks {
+		if t.detail == nil {
+			continue
+		}
+		result = append(result, newUserTask(person, t.taskType, t.detail.TaskType, t.detail.Status, t.detail.DueDate, t.detail.CompletionDate, t.detail.Disabled))
+	}
+
+	if policies := details.AcceptPolicies; policies != nil {
+		result = append(result, newUserTask(person, model.TaskTypeAcceptPolicies, policies.TaskType, policies.Status, policies.DueDate, policies.CompletionDate, policies.Disabled))
+	}
+
+	return result
+}
+
+// newUserTask builds a task row, falling back to the default task type when the API omits it
+func newUserTask(person *model.Person, defaultType model.TaskType, taskType, status string, dueDate, completionDate *time.Time, disabled *model.TaskDisabled) *userTask {
+	if taskType == "" {
+		taskType = string(defaultType)
+	}
+
+	task := &userTask{
+		UserID:         person.ID,
+		UserEmail:      person.EmailAddress,
+		TaskType:       taskType,
+		Status:         status,
+		DueDate:        dueDate,
+		CompletionDate: completionDate,
+	}
+	if person.Name != nil {
+		task.DisplayName = person.Name.Display
+	}
+	if disabled != nil {
+		task.DisabledDate = disabled.Date
+		task.DisabledReason = disabled.Reason
+	}
+
+	return task
+}
+
+//// TRANSFORM FUNCTIONS
+
+// getUserTaskIsDisabled determines if a task has been disabled for the user
+func getUserTaskIsDisabled(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	task, ok := d.HydrateItem.(*userTask)
+	if !ok {
+		return false, nil
+	}
+
+	return task.DisabledDate != nil || task.DisabledReason != "", nil
+}
@@ -2,10 +2,14 @@ package vanta
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/memoize"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"github.com/turbot/steampipe-plugin-vanta/v2/rest_api"
 	"github.com/turbot/steampipe-plugin-vanta/v2/rest_api/model"
 )
 
@@ -20,7 +24,10 @@ func tableVantaVendor(ctx context.Context) *plugin.Table {
 			KeyColumns: plugin.KeyColumnSlice{
 				{Name: "severity", Require: plugin.Optional},
 				{Name: "inherent_risk_level", Require: plugin.Optional},
+				{Name: "residual_risk_level", Require: plugin.Optional},
 				{Name: "status", Require: plugin.Optional},
+				{Name: "name", Require: plugin.Optional},
+				{Name: "next_security_review_due_date", Require: plugin.Optional, Operators: []string{"<", "<=", ">", ">="}},
 			},
 		},
 		Get: &plugin.GetConfig{
@@ -57,6 +64,14 @@ func tableVantaVendor(ctx context.Context) *plugin.Table {
 
 			// Derived columns from nested data
 			{Name: "category_display_name", Type: proto.ColumnType_STRING, Transform: transform.From(getVendorCategoryDisplayName), Description: "The display name of the vendor category."},
+			{Name: "risk_attributes", Type: proto.ColumnType_JSON, Hydrate: getVantaVendorRiskAttributes, Transform: transform.FromValue(), Description: "List of risk attributes assigned to the vendor, resolved from risk_attribute_ids."},
+
+			// Owner columns resolved from the people API
+			{Name: "security_owner_email", Type: proto.ColumnType_STRING, Hydrate: getVantaVendorOwners, Transform: transform.FromField("SecurityOwner.EmailAddress"), Description: "The email of the security owner."},
+			{Name: "security_owner_name", Type: proto.ColumnType_STRING, Hydrate: getVantaVendorOwners, Transform: transform.FromField("SecurityOwner.Name.Display"), Description: "The display name of the security owner."},
+			{Name: "business_owner_email", Type: proto.ColumnType_STRING, Hydrate: getVantaVendorOwners, Transform: transform.FromField("BusinessOwner.EmailAddress"), Description: "The email of the business owner."},
+			{Name: "business_owner_name", Type: proto.ColumnType_STRING, Hydrate: getVantaVendorOwners, Transform: transform.FromField("BusinessOwner.Name.Display"), Description: "The display name of the business owner."},
+			{Name: "owner_is_active", Type: proto.ColumnType_BOOL, Hydrate: getVantaVendorOwners, Transform: transform.From(getVendorOwnerIsActive), Description: "If true, all assigned owners of the vendor are currently employed. False if an owner is no longer employed or has been removed. Null if the vendor has no owners."},
 
 			// Backward compatibility columns (derived from REST API data)
 			{Name: "severity", Type: proto.ColumnType_STRING, Transform: transform.From(getSeverity), Description: "The risk level of the vendor (mapped from inherent_risk_level)."},
@@ -89,6 +104,46 @@ func listVantaVendors(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydrat
 		Cursor: "",
 	}
 
+	// Apply optional filters from key columns
+	if d.EqualsQualString("name") != "" {
+		options.Name = d.EqualsQualString("name")
+	}
+	if d.EqualsQualString("status") != "" {
+		options.Status = d.EqualsQualString("status")
+	}
+	if d.EqualsQualString("inherent_risk_level") != "" {
+		options.InherentRiskLevel = d.EqualsQualString("inherent_risk_level")
+	} else if d.EqualsQualString("severity") != "" {
+		// severity is a backward compatible alias of inherent_risk_level
+		options.InherentRiskLevel = d.EqualsQualString("severity")
+	}
+	if d.EqualsQualString("residual_risk_level") != "" {
+		options.ResidualRiskLevel = d.EqualsQualString("residual_risk_level")
+	}
+	if d.Quals["next_security_review_due_date"] != nil {
+		// The API bounds are exclusive, so inclusive operators are widened by a millisecond, the precision of the API.
+		// If several quals bound the same side, the tightest one is pushed down.
+		for _, q := range d.Quals["next_security_review_due_date"].Quals {
+			reviewDueDate := q.Value.GetTimestampValue().AsTime().UTC()
+			switch q.Operator {
+			case "<", "<=":
+				if q.Operator == "<=" {
+					reviewDueDate = reviewDueDate.Add(time.Millisecond)
+				}
+				if options.SecurityReviewDueBeforeDate == nil || reviewDueDate.Before(*options.SecurityReviewDueBeforeDate) {
+					options.SecurityReviewDueBeforeDate = &reviewDueDate
+				}
+			case ">", ">=":
+				if q.Operator == ">=" {
+					reviewDueDate = reviewDueDate.Add(-time.Millisecond)
+				}
+				if options.SecurityReviewDueAfterDate == nil || reviewDueDate.After(*options.SecurityReviewDueAfterDate) {
+					options.SecurityReviewDueAfterDate = &reviewDueDate
+				}
+			}
+		}
+	}
+
 	for {
 		result, err := client.ListVendors(ctx, options)
 		if err != nil {
@@ -145,8 +200,168 @@ func getVantaVendor(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateD
 	return vendor, nil
 }
 
+//// HYDRATE FUNCTIONS
+
+// getVantaVendorRiskAttributes resolves the risk attribute IDs of a vendor into risk attributes
+func getVantaVendorRiskAttributes(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	vendor, ok := h.Item.(*model.Vendor)
+	if !ok || len(vendor.RiskAttributeIDs) == 0 {
+		return nil, nil
+	}
+
+	result, err := getVendorRiskAttributesByIDMemoized(ctx, d, h)
+	if err != nil {
+		plugin.Logger(ctx).Error("vanta_vendor.getVantaVendorRiskAttributes", "api_error", err)
+		return nil, err
+	}
+	attributes := result.(map[string]*model.VendorRiskAttribute)
+
+	var riskAttributes []*model.VendorRiskAttribute
+	for _, id := range vendor.RiskAttributeIDs {
+		if attribute, ok := attributes[id]; ok {
+			riskAttributes = append(riskAttributes, attribute)
+		} else {
+			// Keep unknown IDs so they are not silently dropped
+			riskAttributes = append(riskAttributes, &model.VendorRiskAttribute{ID: id})
+		}
+	}
+
+	return riskAttributes, nil
+}
+
+// vendorOwners holds the people assigned as owners of a vendor
+type vendorOwners struct {
+	SecurityOwner *model.Person
+	BusinessOwner *model.Person
+
+	// HasRemovedOwner is set if an owner is assigned but the person no longer exists
+	HasRemovedOwner bool
+}
+
+// getVantaVendorOwners resolves the security and business owner user IDs of a vendor into people
+func getVantaVendorOwners(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	vendor, ok := h.Item.(*model.Vendor)
+	if !ok {
+		return nil, nil
+	}
+
+	owners := &vendorOwners{}
+	for _, owner := range []struct {
+		userID string
+		person **model.Person
+	}{
+		{vendor.SecurityOwnerUserID, &owners.SecurityOwner},
+		{vendor.BusinessOwnerUserID, &owners.BusinessOwner},
+	} {
+		if owner.userID == "" {
+			continue
+		}
+
+		person, err := getPersonMemoized(ctx, d, &plugin.HydrateData{Item: owner.userID})
+		if rest_api.IsNotFound(err) {
+			// The owner has been removed from Vanta, e.g. after leaving the company
+			owners.HasRemovedOwner = true
+			continue
+		}
+		if err != nil {
+			plugin.Logger(ctx).Error("vanta_vendor.getVantaVendorOwners", "api_error", err)
+			return nil, err
+		}
+		*owner.person = person.(*model.Person)
+	}
+
+	return owners, nil
+}
+
+// getPersonMemoized caches people by ID so vendors sharing an owner only fetch it once
+var getPersonMemoized = plugin.HydrateFunc(getPerson).Memoize(memoize.WithCacheKeyFunction(getPersonCacheKey))
+
+// getPerson retrieves the person whose ID is passed as the hydrate item
+func getPerson(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	client, err := getClient(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetPersonByID(ctx, h.Item.(string))
+}
+
+// getPersonCacheKey builds a cache key for getPerson from the person ID
+func getPersonCacheKey(_ context.Context, _ *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	return fmt.Sprintf("getPerson-%s", h.Item.(string)), nil
+}
+
+//// HELPER FUNCTIONS
+
+// getVendorIDsForQuery returns the vendor ID from the vendor_id qual, or the IDs of all vendors
+func getVendorIDsForQuery(ctx context.Context, d *plugin.QueryData) ([]string, error) {
+	if vendorID := d.EqualsQualString("vendor_id"); vendorID != "" {
+		return []string{vendorID}, nil
+	}
+
+	client, err := getClient(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	options := &model.ListVendorsOptions{
+		Limit:  100,
+		Cursor: "",
+	}
+
+	var vendorIDs []string
+	for {
+		result, err := client.ListVendors(ctx, options)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, vendor := range result.Results.Data {
+			vendorIDs = append(vendorIDs, vendor.ID)
+		}
+
+		// Check if there are more pages
+		if !result.Results.PageInfo.HasNextPage {
+			break
+		}
+
+		// Set cursor for next page
+		options.Cursor = result.Results.PageInfo.EndCursor
+	}
+
+	return vendorIDs, nil
+}
+
 //// TRANSFORM FUNCTIONS
 
+// getVendorOwnerIsActive determines if all assigned owners of a vendor are currently employed
+func getVendorOwnerIsActive(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	owners, ok := d.HydrateItem.(*vendorOwners)
+	if !ok || owners == nil {
+		return nil, nil
+	}
+
+	if owners.HasRemovedOwner {
+		return false, nil
+	}
+
+	var hasOwner bool
+	for _, person := range []*model.Person{owners.SecurityOwner, owners.BusinessOwner} {
+		if person == nil {
+			continue
+		}
+		hasOwner = true
+		if person.Employment == nil || person.Employment.Status == nil || *person.Employment.Status != model.EmploymentStatusCurrent {
+			return false, nil
+		}
+	}
+	if !hasOwner {
+		return nil, nil
+	}
+
+	return true, nil
+}
+
 // getVendorCategoryDisplayName extracts the category display name from the vendor object
 func getVendorCategoryDisplayName(ctx context.Context, d *transform.TransformData) (interface{}, error) {
 	item := d.HydrateItem
@@ -0,0 +1,215 @@
+package vanta
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"github.com/turbot/steampipe-plugin-vanta/v2/rest_api/model"
+)
+
+// vendorCustomField represents a single custom field value of a vendor
+type vendorCustomField struct {
+	VendorID   string
+	VendorName string
+	Label      string
+	Type       model.CustomFieldType
+	Value      interface{}
+}
+
+//// TABLE DEFINITION
+
+func tableVantaVendorCustomField(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "vanta_vendor_custom_field",
+		Description: "Vanta Vendor Custom Field",
+		List: &plugin.ListConfig{
+			Hydrate: listVantaVendorCustomFields,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "vendor_id", Require: plugin.Optional},
+				{Name: "label", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{Name: "vendor_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("VendorID"), Description: "The ID of the vendor."},
+			{Name: "vendor_name", Type: proto.ColumnType_STRING, Description: "The name of the vendor."},
+			{Name: "label", Type: proto.ColumnType_STRING, Description: "The label of the custom field."},
+			{Name: "type", Type: proto.ColumnType_STRING, Description: "The type of the custom field, e.g. TEXT, NUMBER, DATE, BOOLEAN, SINGLE_SELECT, MULTI_SELECT."},
+			{Name: "value", Type: proto.ColumnType_JSON, Description: "The raw value of the custom field."},
+
+			// Typed value columns
+			{Name: "text_value", Type: proto.ColumnType_STRING, Transform: transform.From(getCustomFieldTextValue), Description: "The value of the custom field, if it is a text or single-select field."},
+			{Name: "number_value", Type: proto.ColumnType_DOUBLE, Transform: transform.From(getCustomFieldNumberValue), Description: "The value of the custom field, if it is a number field."},
+			{Name: "date_value", Type: proto.ColumnType_TIMESTAMP, Transform: transform.From(getCustomFieldDateValue), Description: "The value of the custom field, if it is a date field."},
+			{Name: "boolean_value", Type: proto.ColumnType_BOOL, Transform: transform.From(getCustomFieldBooleanValue), Description: "The value of the custom field, if it is a boolean field."},
+			{Name: "multi_select_values", Type: proto.ColumnType_JSON, Transform: transform.From(getCustomFieldMultiSelectValues), Description: "The selected values of the custom field, if it is a multi-select field."},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listVantaVendorCustomFields(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create REST client
+	client, err := getClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("vanta_vendor_custom_field.listVantaVendorCustomFields", "connection_error", err)
+		return nil, err
+	}
+
+	// Fetch a single vendor if the vendor ID is known
+	if vendorID := d.EqualsQualString("vendor_id"); vendorID != "" {
+		vendor, err := client.GetVendorByID(ctx, vendorID)
+		if err != nil {
+			plugin.Logger(ctx).Error("vanta_vendor_custom_field.listVantaVendorCustomFields", "api_error", err)
+			return nil, err
+		}
+		if vendor != nil {
+			streamVendorCustomFields(ctx, d, vendor)
+		}
+		return nil, nil
+	}
+
+	options := &model.ListVendorsOptions{
+		Limit:  100,
+		Cursor: "",
+	}
+
+	for {
+		result, err := client.ListVendors(ctx, options)
+		if err != nil {
+			plugin.Logger(ctx).Error("vanta_vendor_custom_field.listVantaVendorCustomFields", "api_error", err)
+			return nil, err
+		}
+
+		for _, vendor := range result.Results.Data {
+			if !streamVendorCustomFields(ctx, d, vendor) {
+				return nil, nil
+			}
+		}
+
+		// Check if there are more pages
+		if !result.Results.PageInfo.HasNextPage {
+			break
+		}
+
+		// Set cursor for next page
+		options.Cursor = result.Results.PageInfo.EndCursor
+	}
+
+	return nil, nil
+}
+
+//// HELPER FUNCTIONS
+
+// streamVendorCustomFields streams one row per custom field of the given vendor.
+// Returns false once no more rows are required.
+func streamVendorCustomFields(ctx context.Context, d *plugin.QueryData, vendor *model.Vendor) bool {
+	labelFilter := d.EqualsQualString("label")
+
+	for _, field := range vendor.CustomFields {
+		if field == nil || (labelFilter != "" && field.Label != labelFilter) {
+			continue
+		}
+
+		d.StreamListItem(ctx, &vendorCustomField{
+			VendorID:   vendor.ID,
+			VendorName: vendor.Name,
+			Label:      field.Label,
+			Type:       field.Type,
+			Value:      field.Value,
+		})
+
+		// Check if we should stop (limit reached or context cancelled)
+		if d.RowsRemaining(ctx) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+//// TRANSFORM FUNCTIONS
+
+// getCustomFieldTextValue returns the value of text and single-select custom fields
+func getCustomFieldTextValue(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	field, ok := d.HydrateItem.(*vendorCustomField)
+	if !ok {
+		return nil, nil
+	}
+
+	switch field.Type {
+	case model.CustomFieldTypeText, model.CustomFieldTypeSingleSelect:
+		if value, ok := field.Value.(string); ok {
+			return value, nil
+		}
+	}
+	return nil, nil
+}
+
+// getCustomFieldNumberValue returns the value of number custom fields
+func getCustomFieldNumberValue(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	field, ok := d.HydrateItem.(*vendorCustomField)
+	if !ok || field.Type != model.CustomFieldTypeNumber {
+		return nil, nil
+	}
+
+	switch value := field.Value.(type) {
+	case float64:
+		return value, nil
+	case string:
+		if number, err := strconv.ParseFloat(value, 64); err == nil {
+			return number, nil
+		}
+	}
+	return nil, nil
+}
+
+// getCustomFieldDateValue returns the value of date custom fields
+func getCustomFieldDateValue(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	field, ok := d.HydrateItem.(*vendorCustomField)
+	if !ok || field.Type != model.CustomFieldTypeDate {
+		return nil, nil
+	}
+
+	value, ok := field.Value.(string)
+	if !ok {
+		return nil, nil
+	}
+	for _, layout := range []string{time.RFC3339, time.DateOnly} {
+		if date, err := time.Parse(layout, value); err == nil {
+			return date, nil
+		}
+	}
+	return nil, nil
+}
+
+// getCustomFieldBooleanValue returns the value of boolean custom fields
+func getCustomFieldBooleanValue(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	field, ok := d.HydrateItem.(*vendorCustomField)
+	if !ok || field.Type != model.CustomFieldTypeBoolean {
+		return nil, nil
+	}
+
+	switch value := field.Value.(type) {
+	case bool:
+		return value, nil
+	case string:
+		if boolean, err := strconv.ParseBool(value); err == nil {
+			return boolean, nil
+		}
+	}
+	return nil, nil
+}
+
+// getCustomFieldMultiSelectValues returns the selected values of multi-select custom fields
+func getCustomFieldMultiSelectValues(ctx context.Context, d *transform.TransformData) (interface{}, error) {
+	field, ok := d.HydrateItem.(*vendorCustomField)
+	if !ok || field.Type != model.CustomFieldTypeMultiSelect {
+		return nil, nil
+	}
+
+	return field.Value, nil
+}
@@ -0,0 +1,91 @@
+package vanta
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"github.com/turbot/steampipe-plugin-vanta/v2/rest_api/model"
+)
+
+//// TABLE DEFINITION
+
+func tableVantaVendorFinding(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "vanta_vendor_finding",
+		Description: "Vanta Vendor Finding",
+		List: &plugin.ListConfig{
+			Hydrate: listVantaVendorFindings,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "vendor_id", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Transform: transform.FromField("ID"), Description: "A unique identifier of the finding."},
+			{Name: "vendor_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("VendorID"), Description: "The ID of the vendor the finding belongs to."},
+			{Name: "security_review_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("SecurityReviewID"), Description: "The ID of the security review that raised the finding."},
+			{Name: "content", Type: proto.ColumnType_STRING, Description: "A description of the finding."},
+			{Name: "risk_status", Type: proto.ColumnType_STRING, Description: "The risk status of the finding."},
+			{Name: "remediation", Type: proto.ColumnType_STRING, Description: "The remediation plan for the finding."},
+			{Name: "status", Type: proto.ColumnType_STRING, Description: "The status of the finding."},
+			{Name: "creation_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date when the finding was created."},
+			{Name: "due_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date by which the finding should be resolved."},
+			{Name: "resolved_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date when the finding was resolved."},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listVantaVendorFindings(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create REST client
+	client, err := getClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("vanta_vendor_finding.listVantaVendorFindings", "connection_error", err)
+		return nil, err
+	}
+
+	vendorIDs, err := getVendorIDsForQuery(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("vanta_vendor_finding.listVantaVendorFindings", "api_error", err)
+		return nil, err
+	}
+
+	for _, vendorID := range vendorIDs {
+		options := &model.ListVendorFindingsOptions{
+			Limit:  100,
+			Cursor: "",
+		}
+
+		for {
+			result, err := client.ListVendorFindings(ctx, vendorID, options)
+			if err != nil {
+				plugin.Logger(ctx).Error("vanta_vendor_finding.listVantaVendorFindings", "api_error", err)
+				return nil, err
+			}
+
+			for _, finding := range result.Results.Data {
+				if finding.VendorID == "" {
+					finding.VendorID = vendorID
+				}
+				d.StreamListItem(ctx, finding)
+
+				// Check if we should stop (limit reached or context cancelled)
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+
+			// Check if there are more pages
+			if !result.Results.PageInfo.HasNextPage {
+				break
+			}
+
+			// Set cursor for next page
+			options.Cursor = result.Results.PageInfo.EndCursor
+		}
+	}
+
+	return nil, nil
+}
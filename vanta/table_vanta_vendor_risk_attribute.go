@@ -0,0 +1,120 @@
+package vanta
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"github.com/turbot/steampipe-plugin-vanta/v2/rest_api/model"
+)
+
+//// TABLE DEFINITION
+
+func tableVantaVendorRiskAttribute(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "vanta_vendor_risk_attribute",
+		Description: "Vanta Vendor Risk Attribute",
+		List: &plugin.ListConfig{
+			Hydrate: listVantaVendorRiskAttributes,
+		},
+		Columns: []*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Transform: transform.FromField("ID"), Description: "A unique identifier of the vendor risk attribute."},
+			{Name: "name", Type: proto.ColumnType_STRING, Description: "The name of the vendor risk attribute."},
+			{Name: "description", Type: proto.ColumnType_STRING, Description: "A description of the vendor risk attribute."},
+			{Name: "risk_level_impact", Type: proto.ColumnType_STRING, Description: "The impact of the attribute on the vendor's risk level."},
+			{Name: "enabled", Type: proto.ColumnType_BOOL, Transform: transform.FromField("Enabled"), Description: "If true, the risk attribute is enabled."},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listVantaVendorRiskAttributes(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create REST client
+	client, err := getClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("vanta_vendor_risk_attribute.listVantaVendorRiskAttributes", "connection_error", err)
+		return nil, err
+	}
+
+	maxLimit := int32(100)
+	if d.QueryContext.Limit != nil {
+		limit := int32(*d.QueryContext.Limit)
+		if limit < maxLimit {
+			maxLimit = limit
+		}
+	}
+
+	options := &model.ListVendorRiskAttributesOptions{
+		Limit:  int(maxLimit),
+		Cursor: "",
+	}
+
+	for {
+		result, err := client.ListVendorRiskAttributes(ctx, options)
+		if err != nil {
+			plugin.Logger(ctx).Error("vanta_vendor_risk_attribute.listVantaVendorRiskAttributes", "api_error", err)
+			return nil, err
+		}
+
+		for _, attribute := range result.Results.Data {
+			d.StreamListItem(ctx, attribute)
+
+			// Check if we should stop (limit reached or context cancelled)
+			if d.RowsRemaining(ctx) == 0 {
+				return nil, nil
+			}
+		}
+
+		// Check if there are more pages
+		if !result.Results.PageInfo.HasNextPage {
+			break
+		}
+
+		// Set cursor for next page
+		options.Cursor = result.Results.PageInfo.EndCursor
+	}
+
+	return nil, nil
+}
+
+//// HELPER FUNCTIONS
+
+// getVendorRiskAttributesByIDMemoized caches the risk attribute lookup per connection
+var getVendorRiskAttributesByIDMemoized = plugin.HydrateFunc(getVendorRiskAttributesByID).Memoize()
+
+// getVendorRiskAttributesByID returns all vendor risk attributes keyed by ID
+func getVendorRiskAttributesByID(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := getClient(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	options := &model.ListVendorRiskAttributesOptions{
+		Limit:  100,
+		Cursor: "",
+	}
+
+	attributes := map[string]*model.VendorRiskAttribute{}
+	for {
+		result, err := client.ListVendorRiskAttributes(ctx, options)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, attribute := range result.Results.Data {
+			attributes[attribute.ID] = attribute
+		}
+
+		// Check if there are more pages
+		if !result.Results.PageInfo.HasNextPage {
+			break
+		}
+
+		// Set cursor for next page
+		options.Cursor = result.Results.PageInfo.EndCursor
+	}
+
+	return attributes, nil
+}
@@ -0,0 +1,136 @@
+package vanta
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v5/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v5/plugin/transform"
+	"github.com/turbot/steampipe-plugin-vanta/v2/rest_api/model"
+)
+
+//// TABLE DEFINITION
+
+func tableVantaVendorSecurityReview(ctx context.Context) *plugin.Table {
+	return &plugin.Table{
+		Name:        "vanta_vendor_security_review",
+		Description: "Vanta Vendor Security Review",
+		List: &plugin.ListConfig{
+			Hydrate: listVantaVendorSecurityReviews,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "vendor_id", Require: plugin.Optional},
+			},
+		},
+		Columns: []*plugin.Column{
+			{Name: "id", Type: proto.ColumnType_STRING, Transform: transform.FromField("ID"), Description: "A unique identifier of the security review."},
+			{Name: "vendor_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("VendorID"), Description: "The ID of the vendor that was reviewed."},
+			{Name: "status", Type: proto.ColumnType_STRING, Description: "The status of the security review."},
+			{Name: "start_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date when the security review was started."},
+			{Name: "due_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date by which the security review is due."},
+			{Name: "completion_date", Type: proto.ColumnType_TIMESTAMP, Description: "The date when the security review was completed."},
+			{Name: "reviewer_user_id", Type: proto.ColumnType_STRING, Transform: transform.FromField("ReviewerUserID"), Description: "The user ID of the person who performed the security review."},
+			{Name: "residual_risk_level", Type: proto.ColumnType_STRING, Description: "The residual risk level of the vendor as assessed by the review."},
+			{Name: "summary", Type: proto.ColumnType_STRING, Description: "A summary of the security review."},
+			{Name: "documents", Type: proto.ColumnType_JSON, Hydrate: listVantaVendorSecurityReviewDocuments, Transform: transform.FromValue(), Description: "Documents assessed as part of the security review."},
+		},
+	}
+}
+
+//// LIST FUNCTION
+
+func listVantaVendorSecurityReviews(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	// Create REST client
+	client, err := getClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("vanta_vendor_security_review.listVantaVendorSecurityReviews", "connection_error", err)
+		return nil, err
+	}
+
+	vendorIDs, err := getVendorIDsForQuery(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("vanta_vendor_security_review.listVantaVendorSecurityReviews", "api_error", err)
+		return nil, err
+	}
+
+	for _, vendorID := range vendorIDs {
+		options := &model.ListVendorSecurityReviewsOptions{
+			Limit:  100,
+			Cursor: "",
+		}
+
+		for {
+			result, err := client.ListVendorSecurityReviews(ctx, vendorID, options)
+			if err != nil {
+				plugin.Logger(ctx).Error("vanta_vendor_security_review.listVantaVendorSecurityReviews", "api_error", err)
+				return nil, err
+			}
+
+			for _, review := range result.Results.Data {
+				if review.VendorID == "" {
+					review.VendorID = vendorID
+				}
+				d.StreamListItem(ctx, review)
+
+				// Check if we should stop (limit reached or context cancelled)
+				if d.RowsRemaining(ctx) == 0 {
+					return nil, nil
+				}
+			}
+
+			// Check if there are more pages
+			if !result.Results.PageInfo.HasNextPage {
+				break
+			}
+
+			// Set cursor for next page
+			options.Cursor = result.Results.PageInfo.EndCursor
+		}
+	}
+
+	return nil, nil
+}
+
+//// HYDRATE FUNCTIONS
+
+func listVantaVendorSecurityReviewDocuments(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	review, ok := h.Item.(*model.VendorSecurityReview)
+	if !ok {
+		return nil, nil
+	}
+
+	// Create client
+	client, err := getClient(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("vanta_vendor_security_review.listVantaVendorSecurityReviewDocuments", "connection_error", err)
+		return nil, err
+	}
+
+	options := &model.ListVendorSecurityReviewDocumentsOptions{
+		Limit: 100,
+	}
+
+	var documents []*model.VendorSecurityReviewDocument
+	for {
+		result, err := client.ListVendorSecurityReviewDocuments(ctx, review.VendorID, review.ID, options)
+		if err != nil {
+			plugin.Logger(ctx).Error("vanta_vendor_security_review.listVantaVendorSecurityReviewDocuments", "api_error", err)
+			return nil, err
+		}
+
+		documents = append(documents, result.Results.Data...)
+
+		// Check if there are more pages
+		if !result.Results.PageInfo.HasNextPage {
+			break
+		}
+
+		// Set cursor for next page
+		options.Cursor = result.Results.PageInfo.EndCursor
+	}
+
+	if len(documents) > 0 {
+		return documents, nil
+	}
+
+	return nil, nil
+}